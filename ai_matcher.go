@@ -2,6 +2,7 @@ package main
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -9,6 +10,7 @@ import (
 	"os"
 	"strings"
 	"sync"
+	"unicode/utf8"
 )
 
 // AIConfig holds settings for AI provider
@@ -76,31 +78,86 @@ func loadResume() error {
 }
 
 // scoreJobWithAI sends job details to the configured AI provider
-func scoreJobWithAI(job Job, cfg AIConfig) (int, string, error) {
+func scoreJobWithAI(ctx context.Context, job Job, cfg AIConfig) (int, string, error) {
 	prompt := fmt.Sprintf(`Role: Hiring Manager. 
 Task: Evaluate match for a Fresher/Entry-Level Candidate (0-2 YOE).
 
 Candidate Resume:
 %s
 
-Job Title: %s
-Company: %s
-
+%s
 CRITICAL RULES:
 1. IF Job Title contains "Senior", "Staff", "Lead", "Principal", "Architect", or requires >2 years experience: SCORE MUST BE 0.
 2. IF Job is for "Intern", "New Grad", "Associate", "Junior", or "0-2 years": Score normally based on skill match.
 3. IGNORE skill match if Rule #1 is violated.
 
 Constraint: Return ONLY a JSON object with "score" (0-100) and "reason" (short string).
-Example: {"score": 0, "reason": "Senior role (3+ years) not for fresher"}`, resumeText, job.Title, job.Source)
+Example: {"score": 0, "reason": "Senior role (3+ years) not for fresher"}`, resumeText, describeJobForAI(job))
 
 	if strings.ToLower(cfg.Provider) == "gemini" {
-		return callGeminiAI(cfg, prompt)
+		return callGeminiAI(ctx, cfg, prompt)
+	}
+	return callOllama(ctx, cfg, prompt)
+}
+
+// maxPromptDescription caps the description so long postings don't blow the model's context
+const maxPromptDescription = 3000
+
+// describeJobForAI lists the job's known fields, skipping the ones the source didn't provide
+func describeJobForAI(job Job) string {
+	company := job.Company
+	if company == "" {
+		company = job.Source
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Job Title: %s\n", job.Title)
+	fmt.Fprintf(&b, "Company: %s\n", company)
+	if job.Location != "" {
+		fmt.Fprintf(&b, "Location: %s\n", job.Location)
+	}
+	if job.WorkMode != "" {
+		fmt.Fprintf(&b, "Work Mode: %s\n", job.WorkMode)
+	}
+	if job.hasExperience() {
+		if job.ExperienceMax > 0 {
+			fmt.Fprintf(&b, "Experience Required: %d-%d years\n", job.ExperienceMin, job.ExperienceMax)
+		} else {
+			fmt.Fprintf(&b, "Experience Required: %d+ years\n", job.ExperienceMin)
+		}
+	}
+	if job.EmploymentType != "" {
+		fmt.Fprintf(&b, "Employment Type: %s\n", job.EmploymentType)
+	}
+	if len(job.Tags) > 0 {
+		fmt.Fprintf(&b, "Tags: %s\n", strings.Join(job.Tags, ", "))
+	}
+	if job.Description != "" {
+		desc := job.Description
+		if len(desc) > maxPromptDescription {
+			cut := maxPromptDescription
+			for cut > 0 && !utf8.RuneStart(desc[cut]) {
+				cut-- // Don't split a multi-byte character such as ₹
+			}
+			desc = desc[:cut] + "..."
+		}
+		fmt.Fprintf(&b, "Description:\n%s\n", desc)
+	}
+	return b.String()
+}
+
+// postAI sends a JSON request to the model. It bypasses the shared fetcher:
+// model calls are slow and shouldn't be retried or rate-limited like scraping.
+func postAI(ctx context.Context, url string, payload []byte) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
 	}
-	return callOllama(cfg, prompt)
+	req.Header.Set("Content-Type", "application/json")
+	return http.DefaultClient.Do(req)
 }
 
-func callOllama(cfg AIConfig, prompt string) (int, string, error) {
+func callOllama(ctx context.Context, cfg AIConfig, prompt string) (int, string, error) {
 	reqBody := OllamaRequest{
 		Model:  cfg.Model,
 		Prompt: prompt,
@@ -109,7 +166,7 @@ func callOllama(cfg AIConfig, prompt string) (int, string, error) {
 	}
 
 	jsonData, _ := json.Marshal(reqBody)
-	resp, err := http.Post("http://localhost:11434/api/generate", "application/json", bytes.NewBuffer(jsonData))
+	resp, err := postAI(ctx, "http://localhost:11434/api/generate", jsonData)
 	if err != nil {
 		return 0, "", fmt.Errorf("ollama connection failed: %v", err)
 	}
@@ -128,7 +185,7 @@ func callOllama(cfg AIConfig, prompt string) (int, string, error) {
 	return parseJSONResponse(ollamaResp.Response)
 }
 
-func callGeminiAI(cfg AIConfig, prompt string) (int, string, error) {
+func callGeminiAI(ctx context.Context, cfg AIConfig, prompt string) (int, string, error) {
 	apiKey := os.Getenv("GEMINI_API_KEY")
 	if apiKey == "" {
 		return 0, "", fmt.Errorf("GEMINI_API_KEY not set")
@@ -155,7 +212,7 @@ func callGeminiAI(cfg AIConfig, prompt string) (int, string, error) {
 	}
 
 	jsonData, _ := json.Marshal(reqBody)
-	resp, err := http.Post(url, "application/json", bytes.NewBuffer(jsonData))
+	resp, err := postAI(ctx, url, jsonData)
 	if err != nil {
 		return 0, "", fmt.Errorf("gemini connection failed: %v", err)
 	}
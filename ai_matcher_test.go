@@ -0,0 +1,19 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestDescribeJobForAITruncatesOnRuneBoundary(t *testing.T) {
+	// "₹" is three bytes, so the byte cap lands inside one of them
+	job := Job{Title: "Backend Engineer", Description: "a" + strings.Repeat("₹", maxPromptDescription)}
+	prompt := describeJobForAI(job)
+	if !utf8.ValidString(prompt) {
+		t.Fatalf("prompt is not valid UTF-8")
+	}
+	if !strings.Contains(prompt, "₹...") {
+		t.Errorf("description wasn't truncated with an ellipsis")
+	}
+}
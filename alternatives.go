@@ -1,12 +1,21 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"strings"
 )
 
+func init() {
+	registerSource(newSource("Instahyre", "instahyre", fetchInstahyreJobs))
+	registerSource(newSource("Hirist", "hirist", fetchHiristJobs))
+	registerSource(newSource("Cutshort", "cutshort", fetchCutshortJobs))
+	registerSource(newSource("Internshala", "internshala", fetchInternshalaJobs))
+	registerSource(newSource("Simplify", "simplify", fetchSimplifyJobs))
+}
+
 // Instahyre API response structure
 type InstahyreResponse struct {
 	Jobs []struct {
@@ -20,20 +29,18 @@ type InstahyreResponse struct {
 }
 
 // fetchInstahyreJobs fetches from Instahyre API
-func fetchInstahyreJobs() ([]Job, error) {
+func fetchInstahyreJobs(ctx context.Context) ([]Job, error) {
 	var allJobs []Job
 
 	// Try multiple API endpoints for Instahyre
 	// Endpoint 1: Search API
 	searchURL := "https://www.instahyre.com/api/search/jobs/?experience=0-2&page=1"
-	
-	req, _ := http.NewRequest("GET", searchURL, nil)
-	req.Header.Set("User-Agent", "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/121.0.0.0 Safari/537.36")
-	req.Header.Set("Accept", "application/json, text/plain, */*")
+
+	req, _ := http.NewRequestWithContext(ctx, "GET", searchURL, nil)
 	req.Header.Set("Referer", "https://www.instahyre.com/")
 	req.Header.Set("X-Requested-With", "XMLHttpRequest")
 
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := fetcher.Do(req, profileJSON)
 	if err != nil {
 		fmt.Printf("  Instahyre API error: %v\n", err)
 		return []Job{}, nil
@@ -43,35 +50,22 @@ func fetchInstahyreJobs() ([]Job, error) {
 	if resp.StatusCode != 200 {
 		fmt.Printf("  Instahyre API returned status %d\n", resp.StatusCode)
 		// Try alternative endpoint
-		return fetchInstahyreAlternative()
+		return fetchInstahyreAlternative(ctx)
 	}
 
 	var data InstahyreResponse
 	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
 		fmt.Printf("  Instahyre JSON parse error: %v\n", err)
-		return fetchInstahyreAlternative()
+		return fetchInstahyreAlternative(ctx)
 	}
 
 	for _, j := range data.Jobs {
-		title := j.Title
-		if j.Company != "" {
-			title = fmt.Sprintf("%s @ %s", j.Title, j.Company)
-		}
-		if j.Location != "" {
-			title = fmt.Sprintf("%s (%s)", title, j.Location)
-		}
-
 		slug := j.Slug
 		if slug == "" {
 			slug = fmt.Sprintf("%d", j.ID)
 		}
 
-		allJobs = append(allJobs, Job{
-			ID:     fmt.Sprintf("instahyre-%d", j.ID),
-			Title:  title,
-			Link:   fmt.Sprintf("https://www.instahyre.com/job/%s/", slug),
-			Source: "Instahyre",
-		})
+		allJobs = append(allJobs, instahyreJob(j.ID, j.Title, j.Company, j.Location, j.Experience, slug))
 	}
 
 	// Deduplicate
@@ -87,7 +81,7 @@ func fetchInstahyreJobs() ([]Job, error) {
 	return unique, nil
 }
 
-func fetchInstahyreAlternative() ([]Job, error) {
+func fetchInstahyreAlternative(ctx context.Context) ([]Job, error) {
 	// Try the opportunities endpoint with different parameters
 	searches := []string{
 		"software-engineer",
@@ -97,13 +91,13 @@ func fetchInstahyreAlternative() ([]Job, error) {
 
 	var allJobs []Job
 	for _, search := range searches {
+		if ctx.Err() != nil {
+			break
+		}
 		url := fmt.Sprintf("https://www.instahyre.com/api/v1/candidate/opportunities/?job_type=%s&experience=0-1", search)
 
-		req, _ := http.NewRequest("GET", url, nil)
-		req.Header.Set("User-Agent", "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7)")
-		req.Header.Set("Accept", "application/json")
-
-		resp, err := http.DefaultClient.Do(req)
+		req, _ := http.NewRequestWithContext(ctx, "GET", url, nil)
+		resp, err := fetcher.Do(req, profileJSON)
 		if err != nil {
 			continue
 		}
@@ -121,28 +115,31 @@ func fetchInstahyreAlternative() ([]Job, error) {
 		resp.Body.Close()
 
 		for _, j := range data.Jobs {
-			title := j.Title
-			if j.Company != "" {
-				title = fmt.Sprintf("%s @ %s", j.Title, j.Company)
-			}
-			if j.Location != "" {
-				title = fmt.Sprintf("%s (%s)", title, j.Location)
-			}
-
-			allJobs = append(allJobs, Job{
-				ID:     fmt.Sprintf("instahyre-%d", j.ID),
-				Title:  title,
-				Link:   fmt.Sprintf("https://www.instahyre.com/job/%s/", j.Slug),
-				Source: "Instahyre",
-			})
+			allJobs = append(allJobs, instahyreJob(j.ID, j.Title, j.Company, j.Location, j.Experience, j.Slug))
 		}
 	}
 
 	return allJobs, nil
 }
 
+// instahyreJob maps one Instahyre result; experience comes as e.g. "0-2 years"
+func instahyreJob(id int, title, company, location, experience, slug string) Job {
+	expMin, expMax := parseExperienceRange(experience)
+	return Job{
+		ID:            makeJobID("instahyre", fmt.Sprintf("%d", id), ""),
+		Title:         title,
+		Link:          fmt.Sprintf("https://www.instahyre.com/job/%s/", slug),
+		Source:        "Instahyre",
+		Company:       company,
+		Location:      location,
+		WorkMode:      detectWorkMode(location),
+		ExperienceMin: expMin,
+		ExperienceMax: expMax,
+	}
+}
+
 // fetchHiristJobs fetches from Hirist (another India-focused job board)
-func fetchHiristJobs() ([]Job, error) {
+func fetchHiristJobs(ctx context.Context) ([]Job, error) {
 	var allJobs []Job
 
 	urls := []string{
@@ -151,11 +148,11 @@ func fetchHiristJobs() ([]Job, error) {
 	}
 
 	for _, url := range urls {
-		req, _ := http.NewRequest("GET", url, nil)
-		req.Header.Set("User-Agent", "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7)")
-		req.Header.Set("Accept", "text/html")
-
-		resp, err := http.DefaultClient.Do(req)
+		if ctx.Err() != nil {
+			break
+		}
+		req, _ := http.NewRequestWithContext(ctx, "GET", url, nil)
+		resp, err := fetcher.Do(req, profileBrowser)
 		if err != nil {
 			continue
 		}
@@ -177,14 +174,11 @@ func fetchStackOverflowJobs() ([]Job, error) {
 }
 
 // fetchCutshortJobs fetches from Cutshort
-func fetchCutshortJobs() ([]Job, error) {
+func fetchCutshortJobs(ctx context.Context) ([]Job, error) {
 	url := "https://cutshort.io/jobs?experience=0-2"
 
-	req, _ := http.NewRequest("GET", url, nil)
-	req.Header.Set("User-Agent", "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7)")
-	req.Header.Set("Accept", "text/html,application/xhtml+xml")
-
-	resp, err := http.DefaultClient.Do(req)
+	req, _ := http.NewRequestWithContext(ctx, "GET", url, nil)
+	resp, err := fetcher.Do(req, profileBrowser)
 	if err != nil {
 		return nil, err
 	}
@@ -199,14 +193,11 @@ func fetchCutshortJobs() ([]Job, error) {
 }
 
 // Internshala for internships and fresher jobs
-func fetchInternshalaJobs() ([]Job, error) {
+func fetchInternshalaJobs(ctx context.Context) ([]Job, error) {
 	url := "https://internshala.com/jobs/software-developer-jobs/"
 
-	req, _ := http.NewRequest("GET", url, nil)
-	req.Header.Set("User-Agent", "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7)")
-	req.Header.Set("Accept", "text/html,application/xhtml+xml")
-
-	resp, err := http.DefaultClient.Do(req)
+	req, _ := http.NewRequestWithContext(ctx, "GET", url, nil)
+	resp, err := fetcher.Do(req, profileBrowser)
 	if err != nil {
 		return nil, err
 	}
@@ -234,15 +225,12 @@ type SimplifyJobsResponse struct {
 	} `json:"jobs"`
 }
 
-func fetchSimplifyJobs() ([]Job, error) {
+func fetchSimplifyJobs(ctx context.Context) ([]Job, error) {
 	// Simplify.jobs has a public API
 	url := "https://api.simplify.jobs/v1/jobs?featured=true"
 
-	req, _ := http.NewRequest("GET", url, nil)
-	req.Header.Set("User-Agent", "Mozilla/5.0")
-	req.Header.Set("Accept", "application/json")
-
-	resp, err := http.DefaultClient.Do(req)
+	req, _ := http.NewRequestWithContext(ctx, "GET", url, nil)
+	resp, err := fetcher.Do(req, profileJSON)
 	if err != nil {
 		return nil, err
 	}
@@ -259,19 +247,16 @@ func fetchSimplifyJobs() ([]Job, error) {
 
 	var jobs []Job
 	for _, j := range data.Jobs {
-		title := j.Title
-		if j.CompanyName != "" {
-			title = fmt.Sprintf("%s @ %s", j.Title, j.CompanyName)
-		}
-		if len(j.Locations) > 0 {
-			title = fmt.Sprintf("%s (%s)", title, strings.Join(j.Locations, ", "))
-		}
+		location := strings.Join(j.Locations, ", ")
 
 		jobs = append(jobs, Job{
-			ID:     "simplify-" + j.ID,
-			Title:  title,
-			Link:   j.URL,
-			Source: "Simplify",
+			ID:       makeJobID("simplify", j.ID, j.URL),
+			Title:    j.Title,
+			Link:     j.URL,
+			Source:   "Simplify",
+			Company:  j.CompanyName,
+			Location: location,
+			WorkMode: detectWorkMode(location),
 		})
 	}
 
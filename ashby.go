@@ -0,0 +1,130 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ================== ASHBY ==================
+// Public job board API: api.ashbyhq.com/posting-api/job-board/{name}
+
+func init() {
+	registerATS("ashby", fetchAshbyJobs)
+}
+
+type ashbyResponse struct {
+	Jobs []struct {
+		ID               string `json:"id"`
+		Title            string `json:"title"`
+		Department       string `json:"department"`
+		Team             string `json:"team"`
+		EmploymentType   string `json:"employmentType"` // FullTime, PartTime, Intern, Contract, Temporary
+		Location         string `json:"location"`
+		IsRemote         bool   `json:"isRemote"`
+		WorkplaceType    string `json:"workplaceType"` // Remote, Hybrid, OnSite
+		IsListed         bool   `json:"isListed"`
+		PublishedAt      string `json:"publishedAt"`
+		JobURL           string `json:"jobUrl"`
+		DescriptionPlain string `json:"descriptionPlain"`
+		Compensation     struct {
+			Summary string `json:"compensationTierSummary"`
+		} `json:"compensation"`
+		SecondaryLocations []struct {
+			Location string `json:"location"`
+		} `json:"secondaryLocations"`
+	} `json:"jobs"`
+}
+
+// fetchAshbyJobs reads a company's Ashby job board.
+// company.Token is the board name, e.g. "notion" for jobs.ashbyhq.com/notion.
+func fetchAshbyJobs(ctx context.Context, company CompanyCareer) ([]Job, error) {
+	if company.Token == "" {
+		return nil, fmt.Errorf("ashby: no board name for %s", company.Name)
+	}
+
+	url := fmt.Sprintf("https://api.ashbyhq.com/posting-api/job-board/%s?includeCompensation=true", company.Token)
+
+	var data ashbyResponse
+	if err := getJSON(ctx, url, &data); err != nil {
+		return nil, fmt.Errorf("ashby %s: %w", company.Token, err)
+	}
+
+	var jobs []Job
+	for _, j := range data.Jobs {
+		// Unlisted postings are only reachable by direct link
+		if !j.IsListed {
+			continue
+		}
+
+		if !isEntryLevelJob(j.Title) {
+			continue
+		}
+
+		locations := []string{j.Location}
+		for _, l := range j.SecondaryLocations {
+			locations = append(locations, l.Location)
+		}
+		location := strings.Join(locations, ", ")
+
+		workMode := ashbyWorkMode(j.WorkplaceType)
+		if workMode == "" && j.IsRemote {
+			workMode = WorkModeRemote
+		}
+		if workMode == "" {
+			workMode = detectWorkMode(location)
+		}
+
+		var tags []string
+		for _, t := range []string{j.Department, j.Team} {
+			if t != "" {
+				tags = append(tags, t)
+			}
+		}
+
+		date, _ := time.Parse(time.RFC3339, j.PublishedAt)
+
+		jobs = append(jobs, Job{
+			ID:             makeJobID(companySlug(company.Name), j.ID, j.JobURL),
+			Title:          j.Title,
+			Link:           j.JobURL,
+			Source:         company.Name,
+			Company:        company.Name,
+			Location:       location,
+			WorkMode:       workMode,
+			EmploymentType: ashbyEmploymentType(j.EmploymentType),
+			Description:    strings.TrimSpace(j.DescriptionPlain),
+			Salary:         j.Compensation.Summary,
+			Tags:           tags,
+			Date:           date,
+		})
+	}
+
+	return jobs, nil
+}
+
+func ashbyWorkMode(workplaceType string) WorkMode {
+	switch workplaceType {
+	case "Remote":
+		return WorkModeRemote
+	case "Hybrid":
+		return WorkModeHybrid
+	case "OnSite":
+		return WorkModeOnsite
+	}
+	return ""
+}
+
+// ashbyEmploymentType turns "FullTime" into "Full-time"
+func ashbyEmploymentType(t string) string {
+	switch t {
+	case "FullTime":
+		return "Full-time"
+	case "PartTime":
+		return "Part-time"
+	case "Intern":
+		return "Internship"
+	}
+	return t
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// atsFetchers maps CompanyCareer.ATS to the adapter that reads that
+// platform's public job API instead of scraping the career page
+var atsFetchers = map[string]func(context.Context, CompanyCareer) ([]Job, error){}
+
+// registerATS makes an adapter selectable via `ATS: name` on a company entry
+func registerATS(name string, fetch func(context.Context, CompanyCareer) ([]Job, error)) {
+	atsFetchers[name] = fetch
+}
+
+// atsDetailFetchers return the full posting (description, experience, ...) for
+// one job from a platform's detail endpoint. Platforms whose list API already
+// includes descriptions don't need one.
+var atsDetailFetchers = map[string]func(context.Context, CompanyCareer, Job) (Job, error){}
+
+func registerATSDetail(name string, fetch func(context.Context, CompanyCareer, Job) (Job, error)) {
+	atsDetailFetchers[name] = fetch
+}
+
+// companySlug turns a company name into the prefix used for its job IDs
+func companySlug(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, " ", ""))
+}
+
+// atsJobID strips the company prefix from a job ID, giving the platform's own ID
+func atsJobID(company CompanyCareer, job Job) string {
+	return strings.TrimPrefix(job.ID, companySlug(company.Name)+"-")
+}
+
+// joinNonEmpty joins the non-empty parts with sep
+func joinNonEmpty(sep string, parts ...string) string {
+	var kept []string
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			kept = append(kept, p)
+		}
+	}
+	return strings.Join(kept, sep)
+}
+
+// defaultATSSearch is the keyword query sent to ATS search APIs when a company
+// entry doesn't set its own Search
+const defaultATSSearch = "software engineer"
+
+// atsSearch returns the search text to use for a company
+func atsSearch(company CompanyCareer) string {
+	if company.Search != "" {
+		return company.Search
+	}
+	return defaultATSSearch
+}
+
+// getJSON fetches url and decodes the JSON body into v
+func getJSON(ctx context.Context, url string, v interface{}) error {
+	body, err := httpGet(ctx, url, profileJSON)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(body, v)
+}
+
+// postJSON sends body as JSON to url and decodes the JSON response into v
+func postJSON(ctx context.Context, url string, body, v interface{}) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	data, err := readResponse(fetcher.Do(req, profileJSON))
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// atsPageDelay is the pause between page requests to the same ATS API
+const atsPageDelay = 500 * time.Millisecond
+
+// fetchPages calls fetchPage for pages 0 to maxPages-1 until it reports no
+// more pages. An error on page 0 is returned; a later error, or ctx running
+// out between pages, ends the loop and keeps the pages already fetched.
+func fetchPages(ctx context.Context, maxPages int, fetchPage func(page int) (more bool, err error)) error {
+	for page := 0; page < maxPages; page++ {
+		if page > 0 && sleepContext(ctx, atsPageDelay) != nil {
+			return nil
+		}
+
+		more, err := fetchPage(page)
+		if err != nil {
+			if page == 0 {
+				return err
+			}
+			return nil
+		}
+		if !more {
+			return nil
+		}
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestFetchPages(t *testing.T) {
+	errPage := errors.New("page failed")
+
+	tests := []struct {
+		name      string
+		maxPages  int
+		lastPage  int // last page that reports more
+		failPage  int // -1 for none
+		wantPages []int
+		wantErr   bool
+	}{
+		{name: "single page", maxPages: 5, lastPage: -1, failPage: -1, wantPages: []int{0}},
+		{name: "stops when no more", maxPages: 5, lastPage: 1, failPage: -1, wantPages: []int{0, 1, 2}},
+		{name: "capped at maxPages", maxPages: 3, lastPage: 10, failPage: -1, wantPages: []int{0, 1, 2}},
+		{name: "first page error fails", maxPages: 5, lastPage: 10, failPage: 0, wantPages: []int{0}, wantErr: true},
+		{name: "later error keeps earlier pages", maxPages: 5, lastPage: 10, failPage: 2, wantPages: []int{0, 1, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var pages []int
+			err := fetchPages(context.Background(), tt.maxPages, func(page int) (bool, error) {
+				pages = append(pages, page)
+				if page == tt.failPage {
+					return false, errPage
+				}
+				return page <= tt.lastPage, nil
+			})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("fetchPages() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(pages, tt.wantPages) {
+				t.Errorf("fetchPages() fetched pages %v, want %v", pages, tt.wantPages)
+			}
+		})
+	}
+}
+
+func TestFetchPagesStopsWhenContextDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var pages []int
+	err := fetchPages(ctx, 5, func(page int) (bool, error) {
+		pages = append(pages, page)
+		cancel()
+		return true, nil
+	})
+	if err != nil {
+		t.Fatalf("fetchPages() error = %v, want nil", err)
+	}
+	if !reflect.DeepEqual(pages, []int{0}) {
+		t.Errorf("fetchPages() fetched pages %v, want [0]", pages)
+	}
+}
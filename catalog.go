@@ -0,0 +1,217 @@
+package main
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ================== COMPANY CATALOGUE ==================
+// The built-in companies live in companies.default.yaml, embedded in the
+// binary. Local additions and changes go in companies.yaml, merged over it by
+// name.
+
+//go:embed companies.default.yaml
+var defaultCompaniesYAML []byte
+
+const defaultCompaniesFile = "companies.yaml"
+
+// CompaniesConfig selects which catalogue entries the companies source scans
+type CompaniesConfig struct {
+	File        string   `yaml:"file"`         // Overrides merged over the built-in catalogue (default companies.yaml)
+	Tags        []string `yaml:"tags"`         // Only companies with at least one of these tags
+	ExcludeTags []string `yaml:"exclude_tags"` // Skip companies with any of these tags
+	Countries   []string `yaml:"countries"`    // Only companies headquartered in these countries
+	MaxTier     int      `yaml:"max_tier"`     // Skip tiers above this (0 = all)
+}
+
+// loadCompanyCatalog reads the built-in catalogue, merges the file from config
+// over it and validates the result. All problems are reported together.
+func loadCompanyCatalog(c CompaniesConfig) ([]CompanyCareer, error) {
+	builtin, err := parseCompanyCatalog(defaultCompaniesYAML)
+	if err != nil {
+		return nil, fmt.Errorf("built-in companies.default.yaml: %w", err)
+	}
+
+	path := c.File
+	if path == "" {
+		path = defaultCompaniesFile
+	}
+
+	data, err := os.ReadFile(path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		if c.File != "" {
+			return nil, fmt.Errorf("companies file %s not found", path)
+		}
+		data = nil
+	case err != nil:
+		return nil, err
+	}
+
+	var overrides []CompanyCareer
+	if len(data) > 0 {
+		if overrides, err = parseCompanyCatalog(data); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+	}
+
+	// The merge is keyed by name, so duplicates have to be caught in each file
+	problems := duplicateCompanyNames("built-in companies.default.yaml", builtin)
+	problems = append(problems, duplicateCompanyNames(path, overrides)...)
+
+	companies := mergeCompanyCatalogs(builtin, overrides)
+	problems = append(problems, companyCatalogProblems(companies)...)
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("invalid company catalogue:\n  %s", strings.Join(problems, "\n  "))
+	}
+	return companies, nil
+}
+
+func parseCompanyCatalog(data []byte) ([]CompanyCareer, error) {
+	var companies []CompanyCareer
+	if err := yaml.Unmarshal(data, &companies); err != nil {
+		return nil, err
+	}
+	for i := range companies {
+		if companies[i].ATS == "" && companies[i].LinkAttr == "" {
+			companies[i].LinkAttr = "href"
+		}
+	}
+	return companies, nil
+}
+
+// mergeCompanyCatalogs replaces built-in entries with overrides of the same
+// name (case-insensitive) and appends the rest in file order
+func mergeCompanyCatalogs(builtin, overrides []CompanyCareer) []CompanyCareer {
+	merged := append([]CompanyCareer(nil), builtin...)
+	index := make(map[string]int, len(merged))
+	for i, c := range merged {
+		index[strings.ToLower(c.Name)] = i
+	}
+
+	for _, c := range overrides {
+		key := strings.ToLower(c.Name)
+		if i, ok := index[key]; ok {
+			merged[i] = c
+			continue
+		}
+		index[key] = len(merged)
+		merged = append(merged, c)
+	}
+	return merged
+}
+
+// duplicateCompanyNames reports names (case-insensitive) listed more than
+// once in one catalogue file
+func duplicateCompanyNames(file string, companies []CompanyCareer) []string {
+	var problems []string
+	seen := map[string]bool{}
+	for _, c := range companies {
+		key := strings.ToLower(c.Name)
+		if c.Name == "" {
+			continue // Reported as a missing name
+		}
+		if seen[key] {
+			problems = append(problems, fmt.Sprintf("%s: %s: duplicate name", file, c.Name))
+		}
+		seen[key] = true
+	}
+	return problems
+}
+
+// companyCatalogProblems checks every entry can actually be fetched
+func companyCatalogProblems(companies []CompanyCareer) []string {
+	var problems []string
+
+	for i, c := range companies {
+		label := c.Name
+		if label == "" {
+			label = fmt.Sprintf("entry %d", i+1)
+		}
+
+		if c.Name == "" {
+			problems = append(problems, label+": missing name")
+		}
+
+		if u, err := url.Parse(c.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			problems = append(problems, fmt.Sprintf("%s: invalid url %q", label, c.URL))
+		}
+
+		switch {
+		case c.ATS != "" && atsFetchers[c.ATS] == nil:
+			problems = append(problems, fmt.Sprintf("%s: unknown ats %q", label, c.ATS))
+		case c.ATS == "" && c.Selector == "":
+			problems = append(problems, label+": needs an ats or a selector")
+		}
+
+		if c.Tier < 0 || c.Tier > 3 {
+			problems = append(problems, fmt.Sprintf("%s: tier must be 1-3", label))
+		}
+	}
+
+	return problems
+}
+
+// isEnabled reports whether the entry is switched on (entries are by default)
+func (c CompanyCareer) isEnabled() bool {
+	return c.Enabled == nil || *c.Enabled
+}
+
+// selectCompanies applies the enabled flag and the tag/country/tier filters
+func selectCompanies(companies []CompanyCareer, c CompaniesConfig) []CompanyCareer {
+	var selected []CompanyCareer
+	for _, company := range companies {
+		if !company.isEnabled() {
+			continue
+		}
+		if len(c.Tags) > 0 && !hasAnyFold(company.Tags, c.Tags) {
+			continue
+		}
+		if hasAnyFold(company.Tags, c.ExcludeTags) {
+			continue
+		}
+		if len(c.Countries) > 0 && !hasAnyFold([]string{company.Country}, c.Countries) {
+			continue
+		}
+		if c.MaxTier > 0 && company.Tier > c.MaxTier {
+			continue
+		}
+		selected = append(selected, company)
+	}
+	return selected
+}
+
+func hasAnyFold(values, wanted []string) bool {
+	for _, v := range values {
+		for _, w := range wanted {
+			if strings.EqualFold(v, w) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// catalogTags lists every tag with its company count, for config hints
+func catalogTags(companies []CompanyCareer) []string {
+	counts := map[string]int{}
+	for _, c := range companies {
+		for _, t := range c.Tags {
+			counts[t]++
+		}
+	}
+
+	var tags []string
+	for t, n := range counts {
+		tags = append(tags, fmt.Sprintf("%s (%d)", t, n))
+	}
+	sort.Strings(tags)
+	return tags
+}
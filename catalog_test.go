@@ -0,0 +1,86 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestBuiltinCatalog(t *testing.T) {
+	companies, err := parseCompanyCatalog(defaultCompaniesYAML)
+	if err != nil {
+		t.Fatal(err)
+	}
+	problems := append(duplicateCompanyNames("companies.default.yaml", companies), companyCatalogProblems(companies)...)
+	if len(problems) > 0 {
+		t.Fatalf("built-in catalogue:\n  %s", strings.Join(problems, "\n  "))
+	}
+
+	// companies.yaml in the repo only holds comments, so it changes nothing
+	merged, err := loadCompanyCatalog(CompaniesConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(merged, companies) {
+		t.Errorf("companies.yaml changed the built-in catalogue")
+	}
+}
+
+func TestMergeCompanyCatalogs(t *testing.T) {
+	builtin := []CompanyCareer{
+		{Name: "Acme", URL: "https://acme.com/careers", Selector: "a"},
+		{Name: "Beta", URL: "https://beta.com/jobs", ATS: "lever", Token: "beta"},
+	}
+	overrides := []CompanyCareer{
+		{Name: "beta", URL: "https://jobs.lever.co/beta", ATS: "lever", Token: "beta", Enabled: new(bool)},
+		{Name: "Gamma", URL: "https://gamma.com/careers", Selector: "a.job"},
+	}
+
+	got := mergeCompanyCatalogs(builtin, overrides)
+	want := []CompanyCareer{builtin[0], overrides[0], overrides[1]}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("mergeCompanyCatalogs:\n got %+v\nwant %+v", got, want)
+	}
+	if builtin[1].URL != "https://beta.com/jobs" {
+		t.Errorf("merge modified the built-in catalogue")
+	}
+}
+
+func TestDuplicateCompanyNames(t *testing.T) {
+	overrides := []CompanyCareer{
+		{Name: "Acme", URL: "https://acme.com/careers", Selector: "a"},
+		{Name: "ACME", URL: "https://acme.com/jobs", Selector: "a"},
+		{Name: "Beta", URL: "https://beta.com/jobs", Selector: "a"},
+		{Name: ""},
+	}
+	got := duplicateCompanyNames("my-companies.yaml", overrides)
+	want := []string{"my-companies.yaml: ACME: duplicate name"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("duplicateCompanyNames = %q, want %q", got, want)
+	}
+
+	// The merge keeps one of them, so only the per-file check can see it
+	if problems := companyCatalogProblems(mergeCompanyCatalogs(nil, overrides[:3])); len(problems) != 0 {
+		t.Errorf("merged catalogue problems = %q, want none", problems)
+	}
+}
+
+func TestCompanyCatalogProblems(t *testing.T) {
+	companies := []CompanyCareer{
+		{Name: "", URL: "https://acme.com", Selector: "a"},
+		{Name: "NoURL", URL: "acme.com/careers", Selector: "a"},
+		{Name: "Unknown", URL: "https://x.com", ATS: "taleo"},
+		{Name: "Nothing", URL: "https://x.com"},
+		{Name: "Tier", URL: "https://x.com", Selector: "a", Tier: 4},
+	}
+	want := []string{
+		"entry 1: missing name",
+		`NoURL: invalid url "acme.com/careers"`,
+		`Unknown: unknown ats "taleo"`,
+		"Nothing: needs an ats or a selector",
+		"Tier: tier must be 1-3",
+	}
+	if got := companyCatalogProblems(companies); !reflect.DeepEqual(got, want) {
+		t.Errorf("companyCatalogProblems:\n got %q\nwant %q", got, want)
+	}
+}
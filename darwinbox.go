@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// ================== DARWINBOX ==================
+// Career portals on {tenant}.darwinbox.in/ms/candidate/careers list openings
+// from GET /ms/candidateapi/job
+
+func init() {
+	registerATS("darwinbox", fetchDarwinboxJobs)
+}
+
+const (
+	darwinboxPageSize = 50
+	darwinboxMaxPages = 5
+)
+
+type darwinboxResponse struct {
+	Status  int `json:"status"`
+	Message struct {
+		Total int `json:"total"`
+		Jobs  []struct {
+			ID             string `json:"id"`
+			Title          string `json:"title"`
+			Designation    string `json:"designation"`
+			Department     string `json:"department"`
+			Location       string `json:"officelocation_show_arr"`
+			JobType        string `json:"job_type"`
+			ExperienceFrom string `json:"experience_from"`
+			ExperienceTo   string `json:"experience_to"`
+			CreatedOn      string `json:"created_on"` // DD-MM-YYYY
+			Remote         string `json:"is_remote"`  // "1" or "0"
+		} `json:"jobs"`
+	} `json:"message"`
+}
+
+// fetchDarwinboxJobs pages through a Darwinbox portal's openings. The host
+// comes from company.URL, e.g. https://acme.darwinbox.in/ms/candidate/careers.
+func fetchDarwinboxJobs(ctx context.Context, company CompanyCareer) ([]Job, error) {
+	u, err := url.Parse(company.URL)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.Contains(u.Host, "darwinbox") {
+		return nil, fmt.Errorf("darwinbox: %s is not a darwinbox portal", company.URL)
+	}
+
+	var jobs []Job
+	err = fetchPages(ctx, darwinboxMaxPages, func(page int) (bool, error) {
+		apiURL := fmt.Sprintf("https://%s/ms/candidateapi/job?page=%d&limit=%d", u.Host, page+1, darwinboxPageSize)
+
+		var data darwinboxResponse
+		if err := getJSON(ctx, apiURL, &data); err != nil {
+			return false, err
+		}
+
+		for _, j := range data.Message.Jobs {
+			title := j.Title
+			if title == "" {
+				title = j.Designation
+			}
+
+			if !isEntryLevelJob(title) {
+				continue
+			}
+
+			expMin, expMax := parseExperienceRange(j.ExperienceFrom + "-" + j.ExperienceTo + " years")
+
+			workMode := detectWorkMode(j.Location)
+			if j.Remote == "1" {
+				workMode = WorkModeRemote
+			}
+
+			var tags []string
+			if j.Department != "" {
+				tags = append(tags, j.Department)
+			}
+
+			date, _ := time.Parse("02-01-2006", j.CreatedOn)
+
+			link := fmt.Sprintf("https://%s/ms/candidate/careers/%s", u.Host, j.ID)
+			jobs = append(jobs, Job{
+				ID:             makeJobID(companySlug(company.Name), j.ID, link),
+				Title:          title,
+				Link:           link,
+				Source:         company.Name,
+				Company:        company.Name,
+				Location:       j.Location,
+				WorkMode:       workMode,
+				ExperienceMin:  expMin,
+				ExperienceMax:  expMax,
+				EmploymentType: j.JobType,
+				Tags:           tags,
+				Date:           date,
+			})
+		}
+
+		return len(data.Message.Jobs) == darwinboxPageSize && (page+1)*darwinboxPageSize < data.Message.Total, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("darwinbox %s: %w", u.Host, err)
+	}
+
+	return jobs, nil
+}
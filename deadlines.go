@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ================== DEADLINES ==================
+// A run has an overall deadline and each stage a budget inside it. When time
+// runs out the stage's requests are cancelled and the run carries on with what
+// the stage gathered, so one hung site costs a stage budget, not the whole job.
+
+// DeadlinesConfig is the `deadlines:` block in config.yaml
+type DeadlinesConfig struct {
+	RunMinutes     int `yaml:"run_minutes"`     // Fetching, details and AI scoring together
+	FetchSeconds   int `yaml:"fetch_seconds"`   // Every source, company pages included
+	DetailsSeconds int `yaml:"details_seconds"` // Detail pages of shortlisted jobs
+	AISeconds      int `yaml:"ai_seconds"`      // AI scoring
+	NotifySeconds  int `yaml:"notify_seconds"`  // Telegram; granted even once the run deadline has passed
+}
+
+const (
+	defaultRunMinutes     = 20
+	defaultFetchSeconds   = 600
+	defaultDetailsSeconds = 180
+	defaultAISeconds      = 300
+	defaultNotifySeconds  = 60
+)
+
+// stageContext returns the context for one stage, done when its budget or
+// parent runs out, whichever comes first
+func stageContext(parent context.Context, seconds int) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(parent, time.Duration(seconds)*time.Second)
+}
+
+// reportStageEnd notes a stage that stopped early, so a short run is
+// explained in the log
+func reportStageEnd(ctx context.Context, stage string) {
+	switch {
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		fmt.Printf("⏰ %s ran out of time; continuing with what it gathered\n", stage)
+	case errors.Is(ctx.Err(), context.Canceled):
+		fmt.Printf("⏹️  %s was cancelled; continuing with what it gathered\n", stage)
+	}
+}
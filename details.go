@@ -0,0 +1,316 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// ================== JOB DETAILS ==================
+// Listing pages rarely include the description, so experience requirements
+// written there are invisible to the filters and the AI. After keyword
+// filtering, each candidate's detail page is fetched once and the description
+// (plus anything else structured it reveals) is merged into the job before the
+// run is recorded, so the store keeps it and later runs never refetch it.
+//
+// A job whose fetch fails or runs out of time is held back rather than sent
+// without its description. The failure is kept in details.cache_path by job
+// ID, and the job is retried on the next runs until details.attempts runs out.
+
+// DetailsConfig controls the detail-fetch stage
+type DetailsConfig struct {
+	Enabled     bool   `yaml:"enabled"`
+	MaxJobs     int    `yaml:"max_jobs"`    // At most this many fetches per run
+	Concurrency int    `yaml:"concurrency"` // Parallel fetches
+	CachePath   string `yaml:"cache_path"`  // Failed fetches by job ID, for retrying
+	Attempts    int    `yaml:"attempts"`    // Runs a job is tried before it goes on without details
+}
+
+const (
+	defaultDetailMaxJobs     = 100
+	defaultDetailConcurrency = 4
+	defaultDetailCachePath   = ".cache/details.json"
+	defaultDetailAttempts    = 3
+	detailFailureTTL         = 7 * 24 * time.Hour // Jobs no longer listed are forgotten after this
+)
+
+// detailFailure is a job whose detail fetch hasn't succeeded yet
+type detailFailure struct {
+	Attempts int   `json:"attempts"`
+	LastTry  int64 `json:"last_try"` // Unix time
+}
+
+// detailCache holds the failed detail fetches between runs. A nil
+// *detailCache retries nothing.
+type detailCache struct {
+	path     string
+	failures map[string]detailFailure
+}
+
+// loadDetailCache reads the failures file; a missing or unreadable one is empty
+func loadDetailCache(path string) *detailCache {
+	cache := &detailCache{path: path, failures: map[string]detailFailure{}}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return cache
+	}
+	if err := json.Unmarshal(data, &cache.failures); err != nil {
+		fmt.Printf("Warning: ignoring unreadable detail cache %s: %v\n", path, err)
+		cache.failures = map[string]detailFailure{}
+	}
+	return cache
+}
+
+// pending reports whether a job was held back to retry its details
+func (c *detailCache) pending(id string) bool {
+	if c == nil {
+		return false
+	}
+	_, ok := c.failures[id]
+	return ok
+}
+
+// save writes the failures, without those not retried within detailFailureTTL
+func (c *detailCache) save() error {
+	if c == nil {
+		return nil
+	}
+	for id, f := range c.failures {
+		if time.Since(time.Unix(f.LastTry, 0)) > detailFailureTTL {
+			delete(c.failures, id)
+		}
+	}
+
+	data, err := json.Marshal(c.failures)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(c.path, data, 0644)
+}
+
+// enrichJobDetails fetches details for the jobs that lack a description and
+// merges them into jobs in place. It returns the jobs ready to go on: every
+// job except those whose fetch failed and which have attempts left in cache.
+func enrichJobDetails(ctx context.Context, jobs []Job, c DetailsConfig, cache *detailCache) []Job {
+	var toFetch []int
+	for i := range jobs {
+		if jobs[i].Description == "" {
+			toFetch = append(toFetch, i)
+		}
+	}
+	if len(toFetch) > c.MaxJobs {
+		fmt.Printf("  Fetching details for the first %d of %d jobs (details.max_jobs)\n", c.MaxJobs, len(toFetch))
+		toFetch = toFetch[:c.MaxJobs]
+	}
+
+	fmt.Printf("\n📄 Fetching job details: %d to fetch\n", len(toFetch))
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, c.Concurrency)
+	fetched, failed, skipped := 0, 0, 0
+	var firstErr error
+	held := map[int]bool{}
+	now := time.Now()
+
+	// holdBack notes a failed attempt; false once the job is out of attempts
+	holdBack := func(id string) bool {
+		if cache == nil {
+			return false
+		}
+		f := cache.failures[id]
+		f.Attempts++
+		f.LastTry = now.Unix()
+		cache.failures[id] = f
+		return f.Attempts < c.Attempts
+	}
+
+	for _, i := range toFetch {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			detail, err := fetchJobDetail(ctx, jobs[i])
+
+			mu.Lock()
+			defer mu.Unlock()
+			if ctx.Err() != nil {
+				skipped++ // Out of time
+				held[i] = holdBack(jobs[i].ID)
+				return
+			}
+			if err != nil {
+				failed++
+				if firstErr == nil {
+					firstErr = fmt.Errorf("%s: %w", jobs[i].ID, err)
+				}
+				held[i] = holdBack(jobs[i].ID)
+				return
+			}
+			mergeJobPosting(&jobs[i], detail)
+			fetched++
+		}(i)
+	}
+	wg.Wait()
+
+	if len(toFetch) > 0 {
+		fmt.Printf("  ✓ %d fetched, %d failed\n", fetched, failed)
+	}
+	if firstErr != nil {
+		fmt.Printf("  First failure: %v\n", firstErr)
+	}
+	if skipped > 0 {
+		fmt.Printf("  ⏰ %d skipped: details budget ran out\n", skipped)
+	}
+
+	// Jobs that go on are done with; only held ones stay pending
+	var ready []Job
+	for i, job := range jobs {
+		if held[i] {
+			continue
+		}
+		ready = append(ready, job)
+		if cache != nil {
+			delete(cache.failures, job.ID)
+		}
+	}
+	if n := len(jobs) - len(ready); n > 0 {
+		fmt.Printf("  ⏸️  %d held back to retry their details next run\n", n)
+	}
+	return ready
+}
+
+// fetchJobDetail picks the detail source for a job: the job board's own
+// endpoint, the company's ATS, or the JSON-LD on the job's page
+func fetchJobDetail(ctx context.Context, job Job) (Job, error) {
+	switch {
+	case strings.HasPrefix(job.ID, "linkedin-"):
+		// IDs hashed from the link aren't posting IDs; the link has the real one
+		if m := linkedinJobIDRegex.FindStringSubmatch(job.Link); len(m) > 1 {
+			return fetchLinkedInDetail(ctx, m[1])
+		}
+	case strings.HasPrefix(job.ID, "indeed-"):
+		return fetchIndeedDetail(ctx, job.Link)
+	}
+
+	if company, ok := companyByName(job.Source); ok {
+		if fetch := atsDetailFetchers[company.ATS]; fetch != nil {
+			return fetch(ctx, company, job)
+		}
+	}
+
+	return fetchJSONLDDetail(ctx, job.Link)
+}
+
+// companyByName finds the catalogue entry a company-page job came from
+func companyByName(name string) (CompanyCareer, bool) {
+	for _, c := range companyCareerPages {
+		if c.Name == name {
+			return c, true
+		}
+	}
+	return CompanyCareer{}, false
+}
+
+// fetchJSONLDDetail reads the first JobPosting on a page
+func fetchJSONLDDetail(ctx context.Context, link string) (Job, error) {
+	doc, err := fetchDocument(ctx, link)
+	if err != nil {
+		return Job{}, err
+	}
+	postings := jobPostingsFromHTML(doc, link)
+	if len(postings) == 0 {
+		return Job{}, fmt.Errorf("no JobPosting data on %s", link)
+	}
+	return postings[0], nil
+}
+
+// fetchLinkedInDetail reads the guest job posting fragment LinkedIn serves to
+// its own job pages
+func fetchLinkedInDetail(ctx context.Context, id string) (Job, error) {
+	doc, err := fetchDocument(ctx, "https://www.linkedin.com/jobs-guest/jobs/api/jobPosting/"+id)
+	if err != nil {
+		return Job{}, fmt.Errorf("linkedin: %w", err)
+	}
+
+	detail := Job{
+		Description: htmlToText(innerHTML(doc.Find(".show-more-less-html__markup, .description__text").First())),
+		Location:    strings.TrimSpace(doc.Find(".topcard__flavor--bullet").First().Text()),
+	}
+
+	// "Seniority level: Entry level", "Employment type: Full-time", ...
+	doc.Find(".description__job-criteria-item").Each(func(i int, s *goquery.Selection) {
+		label := strings.TrimSpace(s.Find("h3").Text())
+		value := strings.TrimSpace(s.Find(".description__job-criteria-text").Text())
+		switch label {
+		case "Employment type":
+			detail.EmploymentType = value
+		case "Seniority level":
+			if value != "" && value != "Not Applicable" {
+				detail.Tags = append(detail.Tags, value)
+			}
+		}
+	})
+
+	if datetime, ok := doc.Find("time").First().Attr("datetime"); ok {
+		detail.Date, _ = time.Parse("2006-01-02", datetime)
+	}
+
+	if detail.Description == "" {
+		return Job{}, fmt.Errorf("linkedin: no description for %s", id)
+	}
+	return detail, nil
+}
+
+// fetchIndeedDetail reads an Indeed viewjob page, preferring its JSON-LD
+func fetchIndeedDetail(ctx context.Context, link string) (Job, error) {
+	// Tracking links (/rc/clk?jk=...) redirect to viewjob, but viewjob is cheaper
+	if u, err := url.Parse(link); err == nil {
+		if jk := u.Query().Get("jk"); jk != "" {
+			link = fmt.Sprintf("https://%s/viewjob?jk=%s", u.Host, jk)
+		}
+	}
+
+	doc, err := fetchDocument(ctx, link)
+	if err != nil {
+		return Job{}, fmt.Errorf("indeed: %w", err)
+	}
+
+	if postings := jobPostingsFromHTML(doc, link); len(postings) > 0 {
+		return postings[0], nil
+	}
+
+	description := htmlToText(innerHTML(doc.Find("#jobDescriptionText").First()))
+	if description == "" {
+		return Job{}, fmt.Errorf("indeed: no description on %s", link)
+	}
+	return Job{Description: description}, nil
+}
+
+// fetchDocument GETs and parses an HTML page
+func fetchDocument(ctx context.Context, pageURL string) (*goquery.Document, error) {
+	body, err := httpGet(ctx, pageURL, profileBrowser)
+	if err != nil {
+		return nil, err
+	}
+	return goquery.NewDocumentFromReader(bytes.NewReader(body))
+}
+
+func innerHTML(s *goquery.Selection) string {
+	html, _ := s.Html()
+	return html
+}
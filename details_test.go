@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestEnrichJobDetailsRetriesFailures(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/ok" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(`<html><script type="application/ld+json">
+			{"@type": "JobPosting", "title": "Engineer", "description": "Requires 0-1 years of experience."}
+		</script></html>`))
+	}))
+	defer srv.Close()
+
+	cfg := DetailsConfig{MaxJobs: 10, Concurrency: 2, Attempts: 2}
+	cache := loadDetailCache(filepath.Join(t.TempDir(), "details.json"))
+	newJobs := func() []Job {
+		return []Job{
+			{ID: "acme-ok", Title: "Engineer", Link: srv.URL + "/ok"},
+			{ID: "acme-broken", Title: "Engineer", Link: srv.URL + "/broken"},
+			{ID: "acme-described", Title: "Engineer", Link: srv.URL + "/broken", Description: "Already known"},
+		}
+	}
+
+	// First run: the failing job is held back to retry
+	jobs := newJobs()
+	ready := enrichJobDetails(context.Background(), jobs, cfg, cache)
+	if len(ready) != 2 || ready[0].ID != "acme-ok" || ready[1].ID != "acme-described" {
+		t.Fatalf("first run ready = %+v, want acme-ok and acme-described", ready)
+	}
+	if jobs[0].Description != "Requires 0-1 years of experience." {
+		t.Errorf("acme-ok description = %q, want it merged in place", jobs[0].Description)
+	}
+	if !cache.pending("acme-broken") || cache.pending("acme-ok") {
+		t.Errorf("pending after first run = %v, want only acme-broken", cache.failures)
+	}
+
+	// It survives a save and load
+	if err := cache.save(); err != nil {
+		t.Fatal(err)
+	}
+	cache = loadDetailCache(cache.path)
+	if f := cache.failures["acme-broken"]; f.Attempts != 1 {
+		t.Errorf("reloaded acme-broken = %+v, want 1 attempt", f)
+	}
+
+	// Second run: out of attempts, so it goes on without its description
+	jobs = newJobs()[1:2]
+	ready = enrichJobDetails(context.Background(), jobs, cfg, cache)
+	if len(ready) != 1 || ready[0].ID != "acme-broken" {
+		t.Errorf("second run ready = %+v, want acme-broken", ready)
+	}
+	if cache.pending("acme-broken") {
+		t.Errorf("acme-broken still pending after its last attempt")
+	}
+}
+
+func TestEnrichJobDetailsWithoutCache(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	defer srv.Close()
+
+	jobs := []Job{{ID: "acme-1", Title: "Engineer", Link: srv.URL + "/1"}}
+	ready := enrichJobDetails(context.Background(), jobs, DetailsConfig{MaxJobs: 10, Concurrency: 1, Attempts: 3}, nil)
+	if len(ready) != 1 {
+		t.Errorf("ready = %+v, want the failed job to go on when nothing can retry it", ready)
+	}
+}
+
+func TestDetailCacheSaveForgetsStaleFailures(t *testing.T) {
+	cache := loadDetailCache(filepath.Join(t.TempDir(), "details.json"))
+	cache.failures["fresh"] = detailFailure{Attempts: 1, LastTry: time.Now().Unix()}
+	cache.failures["stale"] = detailFailure{Attempts: 1, LastTry: time.Now().Add(-detailFailureTTL - time.Hour).Unix()}
+
+	if err := cache.save(); err != nil {
+		t.Fatal(err)
+	}
+	cache = loadDetailCache(cache.path)
+	if !cache.pending("fresh") || cache.pending("stale") {
+		t.Errorf("failures after save = %v, want only fresh", cache.failures)
+	}
+
+	var none *detailCache
+	if none.pending("fresh") || none.save() != nil {
+		t.Errorf("nil detail cache should retry and save nothing")
+	}
+}
@@ -0,0 +1,301 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// atsMarker recognises one ATS from a URL or embed code found on a career page
+type atsMarker struct {
+	ATS   string
+	Regex *regexp.Regexp
+	// Build turns the regex match into the company's board URL and token
+	Build func(m []string) (boardURL, token string)
+	// PageToken reads a token that the adapter needs but Build can't know
+	// from the career page or its URL; TokenHint says what it is
+	PageToken *regexp.Regexp
+	TokenHint string
+}
+
+// detectTokenPlaceholder marks a token detect couldn't find
+const detectTokenPlaceholder = "FILL-ME-IN"
+
+// atsMarkers are checked in order against the final URL, then the page HTML
+// (which includes iframe and script src attributes)
+var atsMarkers = []atsMarker{
+	{
+		ATS:   "greenhouse",
+		Regex: regexp.MustCompile(`(?:boards|job-boards)(?:\.eu)?\.greenhouse\.io/(?:embed/job_board(?:/js)?\?for=)?([A-Za-z0-9_-]+)`),
+		Build: func(m []string) (string, string) {
+			return "https://boards.greenhouse.io/" + m[1], m[1]
+		},
+	},
+	{
+		ATS:   "lever",
+		Regex: regexp.MustCompile(`jobs\.lever\.co/([A-Za-z0-9_.-]+)`),
+		Build: func(m []string) (string, string) {
+			return "https://jobs.lever.co/" + m[1], m[1]
+		},
+	},
+	{
+		ATS:   "ashby",
+		Regex: regexp.MustCompile(`jobs\.ashbyhq\.com/([A-Za-z0-9_.-]+)`),
+		Build: func(m []string) (string, string) {
+			return "https://jobs.ashbyhq.com/" + m[1], m[1]
+		},
+	},
+	{
+		ATS:   "workday",
+		Regex: regexp.MustCompile(`([a-z0-9-]+)\.(wd\d+)\.myworkdayjobs\.com/(?:[a-z]{2}-[A-Z]{2}/)?([A-Za-z0-9_-]+)`),
+		Build: func(m []string) (string, string) {
+			// Tenant and site are read back from the URL by the adapter
+			return fmt.Sprintf("https://%s.%s.myworkdayjobs.com/%s", m[1], m[2], m[3]), ""
+		},
+	},
+	{
+		ATS:   "smartrecruiters",
+		Regex: regexp.MustCompile(`(?:jobs|careers)\.smartrecruiters\.com/([A-Za-z0-9_-]+)`),
+		Build: func(m []string) (string, string) {
+			return "https://jobs.smartrecruiters.com/" + m[1], m[1]
+		},
+	},
+	{
+		ATS:   "workable",
+		Regex: regexp.MustCompile(`apply\.workable\.com/([A-Za-z0-9_-]+)`),
+		Build: func(m []string) (string, string) {
+			return "https://apply.workable.com/" + m[1] + "/", m[1]
+		},
+	},
+	{
+		ATS:   "eightfold",
+		Regex: regexp.MustCompile(`([a-z0-9-]+)\.eightfold\.ai`),
+		Build: func(m []string) (string, string) {
+			return "https://" + m[1] + ".eightfold.ai/careers", ""
+		},
+		// The search API needs the tenant's domain, which isn't in the host
+		PageToken: regexp.MustCompile(`(?:[?&]domain=|"domain"\s*:\s*")([a-z0-9-]+(?:\.[a-z0-9-]+)+)`),
+		TokenHint: "the company's domain, e.g. aexp.com",
+	},
+	{
+		ATS:   "oraclehcm",
+		Regex: regexp.MustCompile(`([a-z0-9-]+\.fa(?:\.[a-z0-9-]+)?\.oraclecloud\.com)/hcmUI/CandidateExperience/[a-z]{2}/sites/([A-Za-z0-9_]+)`),
+		Build: func(m []string) (string, string) {
+			return fmt.Sprintf("https://%s/hcmUI/CandidateExperience/en/sites/%s", m[1], m[2]), m[2]
+		},
+	},
+	{
+		ATS:   "darwinbox",
+		Regex: regexp.MustCompile(`([a-z0-9-]+)\.darwinbox\.(in|com)`),
+		Build: func(m []string) (string, string) {
+			return fmt.Sprintf("https://%s.darwinbox.%s/ms/candidate/careers", m[1], m[2]), ""
+		},
+	},
+	{
+		ATS:   "keka",
+		Regex: regexp.MustCompile(`([a-z0-9-]+)\.keka\.com/careers`),
+		Build: func(m []string) (string, string) {
+			// The adapter reads the embed code from this page
+			return "https://" + m[1] + ".keka.com/careers/", ""
+		},
+	},
+	{
+		ATS:   "zohorecruit",
+		Regex: regexp.MustCompile(`([a-z0-9-]+)\.zohorecruit\.(in|com|eu)`),
+		Build: func(m []string) (string, string) {
+			return fmt.Sprintf("https://%s.zohorecruit.%s/jobs/Careers", m[1], m[2]), ""
+		},
+	},
+	{
+		// Phenom and Radancy sites are on the company's own domain; their
+		// search paths are the giveaway, so these are checked last
+		ATS:   "phenom",
+		Regex: regexp.MustCompile(`https?://([a-z0-9.-]+)/([a-z]{2}/[a-z]{2})/search-results`),
+		Build: func(m []string) (string, string) {
+			return "https://" + m[1] + "/" + m[2] + "/search-results", ""
+		},
+	},
+	{
+		ATS:   "radancy",
+		Regex: regexp.MustCompile(`https?://([a-z0-9.-]+)((?:/[a-z]{2}){0,2})/search-jobs`),
+		Build: func(m []string) (string, string) {
+			return "https://" + m[1] + m[2] + "/search-jobs/", ""
+		},
+	},
+}
+
+// Path segments that appear in embed URLs but are never a company token
+var atsTokenStopwords = map[string]bool{
+	"embed": true, "api": true, "js": true, "static": true, "assets": true,
+	"www": true, "app": true, "cdn": true, "static-assets": true,
+}
+
+// matchATS returns the first ATS marker found in text
+func matchATS(text string) (marker atsMarker, boardURL, token string, ok bool) {
+	for _, marker := range atsMarkers {
+		for _, m := range marker.Regex.FindAllStringSubmatch(text, -1) {
+			if atsTokenStopwords[strings.ToLower(m[1])] {
+				continue
+			}
+			boardURL, token = marker.Build(m)
+			return marker, boardURL, token, true
+		}
+	}
+	return atsMarker{}, "", "", false
+}
+
+// atsTokenHint describes the token an ATS's detected entries need filled in
+func atsTokenHint(ats string) string {
+	for _, marker := range atsMarkers {
+		if marker.ATS == ats {
+			return marker.TokenHint
+		}
+	}
+	return ""
+}
+
+// detectedCompany builds the entry for a marker match. A token the marker
+// reads from the page is looked for in each of pages in turn; if it isn't
+// found the entry gets detectTokenPlaceholder.
+func detectedCompany(name string, marker atsMarker, boardURL, token string, pages ...string) CompanyCareer {
+	if token == "" && marker.PageToken != nil {
+		token = detectTokenPlaceholder
+		for _, page := range pages {
+			if m := marker.PageToken.FindStringSubmatch(page); m != nil {
+				token = m[1]
+				break
+			}
+		}
+	}
+	return CompanyCareer{Name: name, URL: boardURL, ATS: marker.ATS, Token: token}
+}
+
+// detectATS fetches a career page (following redirects) and returns a company
+// entry for it. If no ATS is recognised the entry uses the generic selector.
+func detectATS(ctx context.Context, name, careerURL string) (CompanyCareer, error) {
+	if name == "" {
+		name = companyNameFromURL(careerURL)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", careerURL, nil)
+	if err != nil {
+		return CompanyCareer{}, err
+	}
+	resp, err := fetcher.Do(req, profileBrowser)
+	if err != nil {
+		return CompanyCareer{}, err
+	}
+	defer resp.Body.Close()
+
+	var body []byte
+	if resp.StatusCode == 200 {
+		if body, err = io.ReadAll(io.LimitReader(resp.Body, 5<<20)); err != nil {
+			return CompanyCareer{}, err
+		}
+	}
+
+	// Redirects often land straight on the ATS (e.g. /careers -> jobs.lever.co/x)
+	finalURL := resp.Request.URL.String()
+	if marker, boardURL, token, ok := matchATS(finalURL); ok {
+		return detectedCompany(name, marker, boardURL, token, finalURL, string(body)), nil
+	}
+
+	if resp.StatusCode != 200 {
+		return CompanyCareer{}, fmt.Errorf("status %d", resp.StatusCode)
+	}
+
+	if marker, boardURL, token, ok := matchATS(string(body)); ok {
+		return detectedCompany(name, marker, boardURL, token, finalURL, string(body)), nil
+	}
+
+	return CompanyCareer{Name: name, URL: finalURL, Selector: "a[href*='job']", LinkAttr: "href"}, nil
+}
+
+// companyNameFromURL guesses a display name: https://careers.acme.com -> "Acme"
+func companyNameFromURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return rawURL
+	}
+	labels := strings.Split(strings.ToLower(u.Hostname()), ".")
+	for _, l := range labels {
+		switch l {
+		case "www", "careers", "jobs", "career", "apply":
+			continue
+		}
+		if l == "" {
+			continue
+		}
+		return strings.ToUpper(l[:1]) + l[1:]
+	}
+	return u.Hostname()
+}
+
+// formatCompanyEntry renders an entry the way it is written in companies.yaml
+func formatCompanyEntry(c CompanyCareer) string {
+	parts := []string{"name: " + strconv.Quote(c.Name), "url: " + strconv.Quote(c.URL)}
+	if c.ATS == "" {
+		parts = append(parts, "selector: "+strconv.Quote(c.Selector))
+		if c.LinkAttr != "" && c.LinkAttr != "href" {
+			parts = append(parts, "link_attr: "+strconv.Quote(c.LinkAttr))
+		}
+	} else {
+		parts = append(parts, "ats: "+c.ATS)
+		if c.Token != "" {
+			parts = append(parts, "token: "+strconv.Quote(c.Token))
+		}
+	}
+	parts = append(parts, "tags: []")
+	return "- {" + strings.Join(parts, ", ") + "}"
+}
+
+// runDetectCommand implements `go run . detect [--name Company] <url>...`.
+// catalog is checked so an existing entry isn't added twice.
+func runDetectCommand(ctx context.Context, args []string, catalog []CompanyCareer) error {
+	fs := flag.NewFlagSet("detect", flag.ExitOnError)
+	name := fs.String("name", "", "Company name (guessed from the URL if omitted)")
+	fs.Parse(args)
+
+	if fs.NArg() == 0 {
+		return fmt.Errorf("usage: detect [--name Company] <career-page-url>...")
+	}
+
+	for _, careerURL := range fs.Args() {
+		company, err := detectATS(ctx, *name, careerURL)
+		if err != nil {
+			fmt.Printf("# %s: %v\n", careerURL, err)
+			continue
+		}
+
+		for _, existing := range catalog {
+			if strings.EqualFold(existing.Name, company.Name) {
+				fmt.Printf("# %s: %s is already in the catalogue (%s); an entry below replaces it\n", careerURL, existing.Name, existing.URL)
+			}
+		}
+
+		switch {
+		case company.ATS == "":
+			fmt.Printf("# %s: no ATS recognised, using generic selector\n", careerURL)
+		case atsFetchers[company.ATS] == nil:
+			fmt.Printf("# %s: detected %s, which has no adapter yet\n", careerURL, company.ATS)
+		case company.Token == detectTokenPlaceholder:
+			fmt.Printf("# %s: detected %s, but the page doesn't say its token; set token to %s\n", careerURL, company.ATS, atsTokenHint(company.ATS))
+		default:
+			// Prove the entry works before recommending it
+			jobs, err := fetchCompanyJobs(ctx, company)
+			if err != nil {
+				fmt.Printf("# %s: detected %s, but the API failed: %v\n", careerURL, company.ATS, err)
+			} else {
+				fmt.Printf("# %s: detected %s (%d entry-level jobs right now)\n", careerURL, company.ATS, len(jobs))
+			}
+		}
+		fmt.Println(formatCompanyEntry(company))
+	}
+	return nil
+}
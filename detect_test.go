@@ -0,0 +1,74 @@
+package main
+
+import "testing"
+
+func TestDetectFromPage(t *testing.T) {
+	tests := []struct {
+		name      string
+		finalURL  string
+		body      string
+		wantATS   string
+		wantURL   string
+		wantToken string
+	}{
+		{
+			name:      "greenhouse embed",
+			finalURL:  "https://acme.com/careers",
+			body:      `<script src="https://boards.greenhouse.io/embed/job_board/js?for=acme"></script>`,
+			wantATS:   "greenhouse",
+			wantURL:   "https://boards.greenhouse.io/acme",
+			wantToken: "acme",
+		},
+		{
+			name:      "eightfold domain in the redirect URL",
+			finalURL:  "https://aexp.eightfold.ai/careers?domain=aexp.com&location=India",
+			wantATS:   "eightfold",
+			wantURL:   "https://aexp.eightfold.ai/careers",
+			wantToken: "aexp.com",
+		},
+		{
+			name:      "eightfold domain in page settings",
+			finalURL:  "https://careers.acme.com/",
+			body:      `<iframe src="https://acme.eightfold.ai/careers"></iframe><script>var cfg = {"domain": "acme.com"};</script>`,
+			wantATS:   "eightfold",
+			wantURL:   "https://acme.eightfold.ai/careers",
+			wantToken: "acme.com",
+		},
+		{
+			name:      "eightfold without a domain",
+			finalURL:  "https://acme.eightfold.ai/careers",
+			wantATS:   "eightfold",
+			wantURL:   "https://acme.eightfold.ai/careers",
+			wantToken: detectTokenPlaceholder,
+		},
+		{
+			name:     "phenom search results",
+			finalURL: "https://careers.adobe.com/us/en/search-results?keywords=engineer",
+			wantATS:  "phenom",
+			wantURL:  "https://careers.adobe.com/us/en/search-results",
+		},
+		{
+			name:     "radancy search jobs",
+			finalURL: "https://jobs.acme.com/en/search-jobs/India",
+			wantATS:  "radancy",
+			wantURL:  "https://jobs.acme.com/en/search-jobs/",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			marker, boardURL, token, ok := matchATS(tt.finalURL)
+			if !ok {
+				marker, boardURL, token, ok = matchATS(tt.body)
+			}
+			if !ok {
+				t.Fatalf("no ATS detected")
+			}
+
+			got := detectedCompany("Acme", marker, boardURL, token, tt.finalURL, tt.body)
+			if got.ATS != tt.wantATS || got.URL != tt.wantURL || got.Token != tt.wantToken {
+				t.Errorf("detected %s %q token %q, want %s %q token %q", got.ATS, got.URL, got.Token, tt.wantATS, tt.wantURL, tt.wantToken)
+			}
+		})
+	}
+}
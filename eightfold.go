@@ -0,0 +1,151 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// ================== EIGHTFOLD ==================
+// Career sites on {tenant}.eightfold.ai are backed by the public search API:
+// GET /api/apply/v2/jobs?domain={domain}&start=0&num=10&query=...&location=...
+
+func init() {
+	registerATS("eightfold", fetchEightfoldJobs)
+}
+
+const (
+	eightfoldPageSize = 10 // The API ignores larger values
+	eightfoldMaxPages = 10
+)
+
+type eightfoldResponse struct {
+	Count     int `json:"count"`
+	Positions []struct {
+		ID                 int64    `json:"id"`
+		Name               string   `json:"name"`
+		Location           string   `json:"location"`
+		Locations          []string `json:"locations"`
+		Department         string   `json:"department"`
+		BusinessUnit       string   `json:"business_unit"`
+		TCreate            int64    `json:"t_create"` // Unix seconds
+		TUpdate            int64    `json:"t_update"`
+		WorkLocationOption string   `json:"work_location_option"` // onsite, hybrid, remote_local
+		CanonicalURL       string   `json:"canonicalPositionUrl"`
+		JobDescription     string   `json:"job_description"`
+	} `json:"positions"`
+}
+
+// fetchEightfoldJobs pages through an Eightfold tenant's job search.
+// The host comes from company.URL; domain and location are read from its query
+// (e.g. ?domain=aexp.com&location=India). company.Token may override the domain.
+func fetchEightfoldJobs(ctx context.Context, company CompanyCareer) ([]Job, error) {
+	u, err := url.Parse(company.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	query := u.Query()
+	domain := query.Get("domain")
+	if company.Token != "" {
+		domain = company.Token
+	}
+	if domain == "" {
+		return nil, fmt.Errorf("eightfold: no domain for %s (set ?domain= on the URL or token)", company.Name)
+	}
+
+	search := query.Get("query")
+	if search == "" {
+		search = atsSearch(company)
+	}
+
+	var jobs []Job
+	err = fetchPages(ctx, eightfoldMaxPages, func(page int) (bool, error) {
+		params := url.Values{}
+		params.Set("domain", domain)
+		params.Set("start", fmt.Sprintf("%d", page*eightfoldPageSize))
+		params.Set("num", fmt.Sprintf("%d", eightfoldPageSize))
+		params.Set("query", search)
+		params.Set("sort_by", "timestamp")
+		if location := query.Get("location"); location != "" {
+			params.Set("location", location)
+		}
+		apiURL := fmt.Sprintf("https://%s/api/apply/v2/jobs?%s", u.Host, params.Encode())
+
+		var data eightfoldResponse
+		if err := getJSON(ctx, apiURL, &data); err != nil {
+			return false, err
+		}
+
+		for _, p := range data.Positions {
+			if !isEntryLevelJob(p.Name) {
+				continue
+			}
+
+			location := p.Location
+			if len(p.Locations) > 0 {
+				location = strings.Join(p.Locations, "; ")
+			}
+
+			workMode := eightfoldWorkMode(p.WorkLocationOption)
+			if workMode == "" {
+				workMode = detectWorkMode(location)
+			}
+
+			link := p.CanonicalURL
+			if link == "" {
+				link = fmt.Sprintf("https://%s/careers/job/%d", u.Host, p.ID)
+			}
+
+			var tags []string
+			for _, t := range []string{p.Department, p.BusinessUnit} {
+				if t != "" {
+					tags = append(tags, t)
+				}
+			}
+
+			posted := p.TCreate
+			if posted == 0 {
+				posted = p.TUpdate
+			}
+			var date time.Time
+			if posted > 0 {
+				date = time.Unix(posted, 0)
+			}
+
+			jobs = append(jobs, Job{
+				ID:          makeJobID(companySlug(company.Name), fmt.Sprintf("%d", p.ID), link),
+				Title:       p.Name,
+				Link:        link,
+				Source:      company.Name,
+				Company:     company.Name,
+				Location:    location,
+				WorkMode:    workMode,
+				Description: htmlToText(p.JobDescription),
+				Tags:        tags,
+				Date:        date,
+			})
+		}
+
+		return len(data.Positions) == eightfoldPageSize && (page+1)*eightfoldPageSize < data.Count, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("eightfold %s: %w", u.Host, err)
+	}
+
+	return jobs, nil
+}
+
+func eightfoldWorkMode(option string) WorkMode {
+	switch strings.ToLower(option) {
+	case "remote", "remote_local", "remote_global":
+		return WorkModeRemote
+	case "hybrid":
+		return WorkModeHybrid
+	case "onsite", "on_site":
+		return WorkModeOnsite
+	}
+	return ""
+}
@@ -1,11 +1,11 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/url"
 	"strings"
-	"time"
 
 	"github.com/PuerkitoBio/goquery"
 )
@@ -25,7 +25,7 @@ type RecruiterInfo struct {
 
 // EnrichJob attempts to find recruiters and company info
 // This is only called for high-scoring jobs to avoid rate limits
-func EnrichJob(job Job) EnrichedJob {
+func EnrichJob(ctx context.Context, job Job) EnrichedJob {
 	var ej EnrichedJob
 
 	company := extractCompany(job)
@@ -36,7 +36,7 @@ func EnrichJob(job Job) EnrichedJob {
 
 	// 1. Find Recruiters (Fallback Strategy: DuckDuckGo/Google Search)
 	// Query: site:linkedin.com/in "Technical Recruiter" "Company Name"
-	recruiters, err := searchRecruiters(company)
+	recruiters, err := searchRecruiters(ctx, company)
 	if err == nil {
 		ej.Recruiters = recruiters
 	} else {
@@ -51,7 +51,10 @@ func EnrichJob(job Job) EnrichedJob {
 }
 
 func extractCompany(job Job) string {
-	// Heuristic: "Role @ Company"
+	if job.Company != "" {
+		return job.Company
+	}
+	// Heuristic for older records: "Role @ Company"
 	if strings.Contains(job.Title, "@") {
 		parts := strings.Split(job.Title, "@")
 		return strings.TrimSpace(parts[len(parts)-1])
@@ -65,16 +68,13 @@ func extractCompany(job Job) string {
 
 // searchRecruiters performs a search to find potential recruiters
 // We use DuckDuckGo HTML which is easier to scrape than Google
-func searchRecruiters(company string) ([]RecruiterInfo, error) {
+func searchRecruiters(ctx context.Context, company string) ([]RecruiterInfo, error) {
 	// Search query: site:linkedin.com/in "technical recruiter" company "India"
 	query := fmt.Sprintf(`site:linkedin.com/in "technical recruiter" %s "India"`, company)
 	searchURL := fmt.Sprintf("https://html.duckduckgo.com/html/?q=%s", url.QueryEscape(query))
 
-	req, _ := http.NewRequest("GET", searchURL, nil)
-	req.Header.Set("User-Agent", "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7)")
-
-	client := &http.Client{Timeout: 10 * time.Second}
-	resp, err := client.Do(req)
+	req, _ := http.NewRequestWithContext(ctx, "GET", searchURL, nil)
+	resp, err := fetcher.Do(req, profileBrowser)
 	if err != nil {
 		return nil, err
 	}
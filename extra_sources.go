@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -10,6 +11,13 @@ import (
 	"time"
 )
 
+func init() {
+	registerSource(newSource("YC Jobs", "ycjobs", fetchYCJobs))
+	registerSource(newSource("HN Jobs", "hnjobs", fetchHNJobs))
+	registerSource(newSource("Reddit", "reddit", fetchRedditJobs))
+	registerSource(newSource("Triplebyte", "triplebyte", fetchTriplebyteJobs))
+}
+
 // ================== Y COMBINATOR JOBS ==================
 // workatastartup.com - YC company job board
 
@@ -22,34 +30,31 @@ type YCJobResponse struct {
 	URL         string `json:"url"`
 }
 
-func fetchYCJobs() ([]Job, error) {
+func fetchYCJobs(ctx context.Context) ([]Job, error) {
 	// Try multiple approaches for YC jobs
-	
+
 	// Approach 1: Try the jobs API endpoint
 	url := "https://www.workatastartup.com/api/v1/jobs"
 
-	req, _ := http.NewRequest("GET", url, nil)
-	req.Header.Set("User-Agent", "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/121.0.0.0 Safari/537.36")
-	req.Header.Set("Accept", "application/json, text/plain, */*")
+	req, _ := http.NewRequestWithContext(ctx, "GET", url, nil)
 	req.Header.Set("Referer", "https://www.workatastartup.com/jobs")
 
-	client := &http.Client{Timeout: 15 * time.Second}
-	resp, err := client.Do(req)
+	resp, err := fetcher.Do(req, profileJSON)
 	if err != nil {
 		fmt.Printf("  YC API error: %v, trying HTML fallback\n", err)
-		return fetchYCJobsHTML()
+		return fetchYCJobsHTML(ctx)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != 200 {
 		fmt.Printf("  YC API returned status %d, trying HTML fallback\n", resp.StatusCode)
-		return fetchYCJobsHTML()
+		return fetchYCJobsHTML(ctx)
 	}
 
 	// Try to parse as JSON array directly
 	var jobsArray []YCJobResponse
 	body, _ := io.ReadAll(resp.Body)
-	
+
 	// Try direct array parse
 	if err := json.Unmarshal(body, &jobsArray); err == nil && len(jobsArray) > 0 {
 		return parseYCJobs(jobsArray), nil
@@ -65,24 +70,14 @@ func fetchYCJobs() ([]Job, error) {
 
 	// Fallback to HTML scraping
 	fmt.Println("  YC JSON parsing failed, trying HTML fallback")
-	return fetchYCJobsHTML()
+	return fetchYCJobsHTML(ctx)
 }
 
 func parseYCJobs(jobsData []YCJobResponse) []Job {
 	var jobs []Job
 	for _, j := range jobsData {
-		title := j.Title
-		if j.CompanyName != "" {
-			title = fmt.Sprintf("%s @ %s", j.Title, j.CompanyName)
-		}
-		if j.Remote {
-			title += " (Remote)"
-		} else if j.Location != "" {
-			title += fmt.Sprintf(" (%s)", j.Location)
-		}
-
 		// Apply experience filter
-		if !isEntryLevelJob(title) {
+		if !isEntryLevelJob(j.Title) {
 			continue
 		}
 
@@ -91,25 +86,31 @@ func parseYCJobs(jobsData []YCJobResponse) []Job {
 			link = fmt.Sprintf("https://www.workatastartup.com/jobs/%d", j.ID)
 		}
 
+		workMode := detectWorkMode(j.Location)
+		if j.Remote {
+			workMode = WorkModeRemote
+		} else if workMode == "" && j.Location != "" {
+			workMode = WorkModeOnsite
+		}
+
 		jobs = append(jobs, Job{
-			ID:     fmt.Sprintf("yc-%d", j.ID),
-			Title:  title,
-			Link:   link,
-			Source: "YC Jobs",
+			ID:       makeJobID("yc", fmt.Sprintf("%d", j.ID), link),
+			Title:    j.Title,
+			Link:     link,
+			Source:   "YC Jobs",
+			Company:  j.CompanyName,
+			Location: j.Location,
+			WorkMode: workMode,
 		})
 	}
 	return jobs
 }
 
-func fetchYCJobsHTML() ([]Job, error) {
+func fetchYCJobsHTML(ctx context.Context) ([]Job, error) {
 	url := "https://www.workatastartup.com/jobs"
 
-	req, _ := http.NewRequest("GET", url, nil)
-	req.Header.Set("User-Agent", "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/121.0.0.0 Safari/537.36")
-	req.Header.Set("Accept", "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8")
-
-	client := &http.Client{Timeout: 15 * time.Second}
-	resp, err := client.Do(req)
+	req, _ := http.NewRequestWithContext(ctx, "GET", url, nil)
+	resp, err := fetcher.Do(req, profileBrowser)
 	if err != nil {
 		fmt.Printf("  YC HTML fetch error: %v\n", err)
 		return []Job{}, nil // Return empty instead of error to not break the whole flow
@@ -141,13 +142,13 @@ func fetchYCJobsHTML() ([]Job, error) {
 			if len(match) < 2 || i >= 30 {
 				break
 			}
-			
+
 			path := match[1]
 			// Normalize path
 			if !strings.HasPrefix(path, "/jobs/") && !strings.HasPrefix(path, "http") {
 				path = "/jobs/" + path
 			}
-			
+
 			if seen[path] {
 				continue
 			}
@@ -155,20 +156,21 @@ func fetchYCJobsHTML() ([]Job, error) {
 
 			jobID := strings.TrimPrefix(path, "/jobs/")
 			jobID = strings.TrimPrefix(jobID, "https://www.workatastartup.com/jobs/")
-			
+
 			link := path
 			if !strings.HasPrefix(link, "http") {
 				link = "https://www.workatastartup.com" + path
 			}
 
 			jobs = append(jobs, Job{
-				ID:     "yc-" + jobID,
-				Title:  "Software Engineer @ YC Startup",
-				Link:   link,
-				Source: "YC Jobs",
+				ID:      makeJobID("yc", jobID, link),
+				Title:   "Software Engineer",
+				Link:    link,
+				Source:  "YC Jobs",
+				Company: "YC Startup",
 			})
 		}
-		
+
 		if len(jobs) > 0 {
 			break // Found jobs with this pattern
 		}
@@ -184,14 +186,13 @@ func fetchYCJobsHTML() ([]Job, error) {
 // ================== HACKER NEWS JOBS ==================
 // Monthly "Who's Hiring?" thread
 
-func fetchHNJobs() ([]Job, error) {
+func fetchHNJobs(ctx context.Context) ([]Job, error) {
 	// Get the latest "Who is hiring?" thread from HN
 	// Search for the thread ID
 	searchURL := "https://hn.algolia.com/api/v1/search?query=who%20is%20hiring&tags=ask_hn&hitsPerPage=5"
 
-	req, _ := http.NewRequest("GET", searchURL, nil)
-	client := &http.Client{Timeout: 15 * time.Second}
-	resp, err := client.Do(req)
+	req, _ := http.NewRequestWithContext(ctx, "GET", searchURL, nil)
+	resp, err := fetcher.Do(req, profileJSON)
 	if err != nil {
 		return nil, err
 	}
@@ -224,12 +225,6 @@ func fetchHNJobs() ([]Job, error) {
 
 	// Get comments from the thread
 	itemURL := fmt.Sprintf("https://hn.algolia.com/api/v1/items/%s", threadID)
-	resp2, err := client.Get(itemURL)
-	if err != nil {
-		return nil, err
-	}
-	defer resp2.Body.Close()
-
 	var item struct {
 		Children []struct {
 			ID     int    `json:"id"`
@@ -238,7 +233,7 @@ func fetchHNJobs() ([]Job, error) {
 		} `json:"children"`
 	}
 
-	if err := json.NewDecoder(resp2.Body).Decode(&item); err != nil {
+	if err := getJSON(ctx, itemURL, &item); err != nil {
 		return nil, err
 	}
 
@@ -268,11 +263,25 @@ func fetchHNJobs() ([]Job, error) {
 			continue
 		}
 
+		// Convention is "Company | Role | Location | REMOTE/ONSITE"
+		var company, location string
+		if parts := strings.Split(firstLine, "|"); len(parts) > 1 {
+			company = strings.TrimSpace(parts[0])
+			if len(parts) > 2 {
+				location = strings.TrimSpace(parts[2])
+			}
+		}
+
+		link := fmt.Sprintf("https://news.ycombinator.com/item?id=%d", child.ID)
 		jobs = append(jobs, Job{
-			ID:     fmt.Sprintf("hn-%d", child.ID),
-			Title:  firstLine,
-			Link:   fmt.Sprintf("https://news.ycombinator.com/item?id=%d", child.ID),
-			Source: "HN Jobs",
+			ID:          makeJobID("hn", fmt.Sprintf("%d", child.ID), link),
+			Title:       firstLine,
+			Link:        link,
+			Source:      "HN Jobs",
+			Company:     company,
+			Location:    location,
+			WorkMode:    detectWorkMode(firstLine),
+			Description: htmlToText(text),
 		})
 	}
 
@@ -282,7 +291,7 @@ func fetchHNJobs() ([]Job, error) {
 // ================== REDDIT JOBS ==================
 // r/cscareerquestions and r/forhire
 
-func fetchRedditJobs() ([]Job, error) {
+func fetchRedditJobs(ctx context.Context) ([]Job, error) {
 	subreddits := []string{
 		"cscareerquestions",
 		"forhire",
@@ -291,14 +300,16 @@ func fetchRedditJobs() ([]Job, error) {
 	var allJobs []Job
 
 	for _, sub := range subreddits {
+		if ctx.Err() != nil {
+			break
+		}
 		// Search for hiring posts
 		url := fmt.Sprintf("https://www.reddit.com/r/%s/search.json?q=hiring+OR+job&sort=new&t=week&limit=25", sub)
 
-		req, _ := http.NewRequest("GET", url, nil)
-		req.Header.Set("User-Agent", "JobWatcher/1.0")
+		req, _ := http.NewRequestWithContext(ctx, "GET", url, nil)
+		req.Header.Set("User-Agent", "JobWatcher/1.0") // Reddit's API asks clients to identify themselves
 
-		client := &http.Client{Timeout: 15 * time.Second}
-		resp, err := client.Do(req)
+		resp, err := fetcher.Do(req, profileJSON)
 		if err != nil {
 			continue
 		}
@@ -344,10 +355,13 @@ func fetchRedditJobs() ([]Job, error) {
 			link := "https://www.reddit.com" + post.Permalink
 
 			allJobs = append(allJobs, Job{
-				ID:     "reddit-" + post.ID,
-				Title:  title,
-				Link:   link,
-				Source: "Reddit",
+				ID:          makeJobID("reddit", post.ID, link),
+				Title:       title,
+				Link:        link,
+				Source:      "Reddit",
+				WorkMode:    detectWorkMode(title),
+				Description: post.Selftext,
+				Date:        time.Unix(int64(post.Created), 0),
 			})
 		}
 	}
@@ -359,15 +373,12 @@ func fetchRedditJobs() ([]Job, error) {
 // Note: Triplebyte was acquired by Karat - limited public access
 // This source often fails and is disabled by default
 
-func fetchTriplebyteJobs() ([]Job, error) {
+func fetchTriplebyteJobs(ctx context.Context) ([]Job, error) {
 	// Triplebyte was acquired by Karat, limited public access
 	url := "https://triplebyte.com/jobs"
 
-	req, _ := http.NewRequest("GET", url, nil)
-	req.Header.Set("User-Agent", "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7)")
-
-	client := &http.Client{Timeout: 15 * time.Second}
-	resp, err := client.Do(req)
+	req, _ := http.NewRequestWithContext(ctx, "GET", url, nil)
+	resp, err := fetcher.Do(req, profileBrowser)
 	if err != nil {
 		fmt.Printf("  Triplebyte fetch error: %v (expected - site has limited public access)\n", err)
 		return []Job{}, nil // Return empty, don't propagate error
@@ -398,7 +409,7 @@ func fetchTriplebyteJobs() ([]Job, error) {
 		seen[path] = true
 
 		jobs = append(jobs, Job{
-			ID:     "triplebyte-" + fmt.Sprintf("%d", i),
+			ID:     makeJobID("triplebyte", "", "https://triplebyte.com"+path),
 			Title:  "Software Engineer",
 			Link:   "https://triplebyte.com" + path,
 			Source: "Triplebyte",
@@ -415,15 +426,12 @@ func fetchTriplebyteJobs() ([]Job, error) {
 // ================== HIRED.COM ==================
 // Reverse job board - companies apply to you
 
-func fetchHiredJobs() ([]Job, error) {
+func fetchHiredJobs(ctx context.Context) ([]Job, error) {
 	// Hired.com requires signup, but we can scrape featured companies
 	url := "https://hired.com/companies"
 
-	req, _ := http.NewRequest("GET", url, nil)
-	req.Header.Set("User-Agent", "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7)")
-
-	client := &http.Client{Timeout: 15 * time.Second}
-	resp, err := client.Do(req)
+	req, _ := http.NewRequestWithContext(ctx, "GET", url, nil)
+	resp, err := fetcher.Do(req, profileBrowser)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,312 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// ================== RSS / ATOM FEEDS ==================
+// Job boards and company blogs that publish RSS 2.0, RSS 1.0 (RDF) or Atom
+// feeds. Listed under `feeds:` in config.yaml; the indeed source reads its
+// `indeed_rss` URLs through the same parser.
+
+func init() {
+	registerSource(newSource("Feeds", "feeds", func(ctx context.Context) ([]Job, error) {
+		return fetchFeedJobs(ctx, cfg.Feeds)
+	}))
+}
+
+// FeedConfig is one entry of `feeds:` in config.yaml
+type FeedConfig struct {
+	Name     string `yaml:"name"`     // Shown as the job's source, e.g. "We Work Remotely"
+	URL      string `yaml:"url"`      // RSS or Atom feed
+	Company  string `yaml:"company"`  // For single-company feeds; else taken from the title
+	Location string `yaml:"location"` // Used when items don't carry one
+}
+
+// feedItem is an RSS item or Atom entry reduced to the fields jobs use
+type feedItem struct {
+	Title       string
+	Link        string
+	GUID        string
+	Published   time.Time
+	Description string // HTML
+	Categories  []string
+	Author      string
+	Region      string // We Work Remotely's <region>
+}
+
+// feedDocument decodes all three formats. encoding/xml matches tags by local
+// name when no namespace is given, so <rss>, <rdf:RDF> and <feed> share it.
+type feedDocument struct {
+	Channel struct {
+		Items []rssItem `xml:"item"`
+	} `xml:"channel"`
+	Items   []rssItem   `xml:"item"`  // RSS 1.0 puts items beside the channel
+	Entries []atomEntry `xml:"entry"` // Atom
+}
+
+type rssItem struct {
+	Title       string   `xml:"title"`
+	Link        string   `xml:"link"`
+	GUID        string   `xml:"guid"`
+	PubDate     string   `xml:"pubDate"`
+	Date        string   `xml:"http://purl.org/dc/elements/1.1/ date"`
+	Description string   `xml:"description"`
+	Content     string   `xml:"http://purl.org/rss/1.0/modules/content/ encoded"`
+	Categories  []string `xml:"category"`
+	Author      string   `xml:"author"`
+	Creator     string   `xml:"http://purl.org/dc/elements/1.1/ creator"`
+	Source      string   `xml:"source"` // Indeed: the employer
+	Region      string   `xml:"region"`
+}
+
+type atomEntry struct {
+	Title string `xml:"title"`
+	Links []struct {
+		Href string `xml:"href,attr"`
+		Rel  string `xml:"rel,attr"`
+	} `xml:"link"`
+	ID        string `xml:"id"`
+	Published string `xml:"published"`
+	Updated   string `xml:"updated"`
+	Summary   string `xml:"summary"`
+	Content   string `xml:"content"`
+	Category  []struct {
+		Term  string `xml:"term,attr"`
+		Label string `xml:"label,attr"`
+	} `xml:"category"`
+	Author struct {
+		Name string `xml:"name"`
+	} `xml:"author"`
+}
+
+// feedDateLayouts covers RFC 822 in its common variants and ISO 8601
+var feedDateLayouts = []string{
+	time.RFC1123Z,
+	time.RFC1123,
+	"Mon, 2 Jan 2006 15:04:05 -0700",
+	"Mon, 2 Jan 2006 15:04:05 MST",
+	"2 Jan 2006 15:04:05 -0700",
+	time.RFC822Z,
+	time.RFC822,
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02",
+}
+
+func fetchFeedJobs(ctx context.Context, feeds []FeedConfig) ([]Job, error) {
+	var allJobs []Job
+	for _, feed := range feeds {
+		if ctx.Err() != nil {
+			break
+		}
+		items, err := fetchFeed(ctx, feed.URL)
+		if err != nil {
+			fmt.Printf("  Warning: feed %s failed: %v\n", feed.Name, err)
+			continue
+		}
+		for _, item := range items {
+			allJobs = append(allJobs, feedItemToJob(feed, item))
+		}
+	}
+	return allJobs, nil
+}
+
+// feedItemToJob maps a feed item to a Job. Titles that name the employer
+// ("Acme: Backend Engineer", "Backend Engineer at Acme") are split when the
+// feed isn't for a single company.
+func feedItemToJob(feed FeedConfig, item feedItem) Job {
+	title, company := item.Title, feed.Company
+	if company == "" {
+		title, company = splitFeedTitle(item.Title)
+	}
+	if company == "" {
+		company = item.Author
+	}
+
+	location := item.Region
+	if location == "" {
+		location = feed.Location
+	}
+
+	// A guid that isn't the link is the feed's own ID for the item
+	var nativeID string
+	if item.GUID != "" && item.GUID != item.Link {
+		nativeID = generateStableHash(item.GUID)
+	}
+	name := feed.Name
+	if name == "" {
+		name = feedHost(feed.URL)
+	}
+
+	description := htmlToText(item.Description)
+	expMin, expMax := parseExperienceRange(description)
+
+	return Job{
+		ID:            makeJobID("feed-"+companySlug(name), nativeID, item.Link),
+		Title:         title,
+		Link:          item.Link,
+		Source:        name,
+		Company:       company,
+		Location:      location,
+		WorkMode:      detectWorkMode(location + " " + title),
+		ExperienceMin: expMin,
+		ExperienceMax: expMax,
+		Description:   description,
+		Tags:          item.Categories,
+		Date:          item.Published,
+	}
+}
+
+// splitFeedTitle separates the employer from "Company: Title" (We Work
+// Remotely) and "Title at Company" (many ATS feeds)
+func splitFeedTitle(title string) (string, string) {
+	if company, role, ok := strings.Cut(title, ": "); ok && len(company) < 60 {
+		return strings.TrimSpace(role), strings.TrimSpace(company)
+	}
+	if i := strings.LastIndex(title, " at "); i > 0 {
+		return strings.TrimSpace(title[:i]), strings.TrimSpace(title[i+4:])
+	}
+	return title, ""
+}
+
+func feedHost(feedURL string) string {
+	if u, err := url.Parse(feedURL); err == nil && u.Host != "" {
+		return strings.TrimPrefix(u.Host, "www.")
+	}
+	return feedURL
+}
+
+// fetchFeed downloads and parses one feed
+func fetchFeed(ctx context.Context, feedURL string) ([]feedItem, error) {
+	data, err := httpGet(ctx, feedURL, profileFeed)
+	if err != nil {
+		return nil, err
+	}
+	return parseFeed(data, feedURL)
+}
+
+// parseFeed reads RSS 2.0, RSS 1.0 or Atom. Relative links are resolved
+// against feedURL.
+func parseFeed(data []byte, feedURL string) ([]feedItem, error) {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) > 0 && trimmed[0] != '<' {
+		return nil, fmt.Errorf("not an XML feed")
+	}
+	if bytes.Contains(bytes.ToLower(trimmed[:min(len(trimmed), 512)]), []byte("<html")) {
+		return nil, fmt.Errorf("got an HTML page instead of a feed") // Usually a bot challenge
+	}
+
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	decoder.Strict = false // Feeds in the wild have bare & and HTML entities
+	decoder.Entity = xml.HTMLEntity
+	// Non-UTF-8 feeds are rare; read them as is rather than failing
+	decoder.CharsetReader = func(label string, input io.Reader) (io.Reader, error) {
+		return input, nil
+	}
+
+	var doc feedDocument
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, err
+	}
+
+	base, _ := url.Parse(feedURL)
+	resolve := func(link string) string {
+		link = strings.TrimSpace(link)
+		if base == nil || link == "" {
+			return link
+		}
+		if ref, err := url.Parse(link); err == nil {
+			return base.ResolveReference(ref).String()
+		}
+		return link
+	}
+
+	var items []feedItem
+	for _, r := range append(doc.Channel.Items, doc.Items...) {
+		description := r.Description
+		if len(r.Content) > len(description) {
+			description = r.Content
+		}
+		categories := make([]string, 0, len(r.Categories))
+		for _, c := range r.Categories {
+			if c = strings.TrimSpace(c); c != "" {
+				categories = append(categories, c)
+			}
+		}
+
+		items = append(items, feedItem{
+			Title:       strings.TrimSpace(r.Title),
+			Link:        resolve(r.Link),
+			GUID:        strings.TrimSpace(r.GUID),
+			Published:   parseFeedDate(firstNonEmpty(r.PubDate, r.Date)),
+			Description: description,
+			Categories:  categories,
+			Author:      firstNonEmpty(r.Source, r.Creator, r.Author),
+			Region:      strings.TrimSpace(r.Region),
+		})
+	}
+
+	for _, e := range doc.Entries {
+		var link string
+		for _, l := range e.Links {
+			if l.Rel == "" || l.Rel == "alternate" {
+				link = l.Href
+				break
+			}
+		}
+		if link == "" && len(e.Links) > 0 {
+			link = e.Links[0].Href
+		}
+
+		description := e.Summary
+		if len(e.Content) > len(description) {
+			description = e.Content
+		}
+		var categories []string
+		for _, c := range e.Category {
+			if label := firstNonEmpty(c.Label, c.Term); label != "" {
+				categories = append(categories, label)
+			}
+		}
+
+		items = append(items, feedItem{
+			Title:       strings.TrimSpace(e.Title),
+			Link:        resolve(link),
+			GUID:        strings.TrimSpace(e.ID),
+			Published:   parseFeedDate(firstNonEmpty(e.Published, e.Updated)),
+			Description: description,
+			Categories:  categories,
+			Author:      strings.TrimSpace(e.Author.Name),
+		})
+	}
+
+	return items, nil
+}
+
+// firstNonEmpty returns the first value that isn't blank, trimmed
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+func parseFeedDate(s string) time.Time {
+	s = strings.TrimSpace(s)
+	for _, layout := range feedDateLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
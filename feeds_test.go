@@ -0,0 +1,151 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseFeed(t *testing.T) {
+	tests := []struct {
+		name  string
+		feed  string
+		want  feedItem
+		count int
+	}{
+		{
+			name: "rss 2.0",
+			feed: `<?xml version="1.0"?>
+<rss version="2.0" xmlns:dc="http://purl.org/dc/elements/1.1/">
+<channel><title>Jobs</title>
+<item>
+  <title>Acme: Backend Engineer</title>
+  <link>/jobs/42</link>
+  <guid>acme-42</guid>
+  <pubDate>Mon, 02 Jun 2025 10:00:00 +0000</pubDate>
+  <description><![CDATA[<p>Build APIs &amp; services</p>]]></description>
+  <category> Backend </category><category></category>
+  <dc:creator>Acme</dc:creator>
+  <region>Anywhere in the World</region>
+</item>
+<item><title>Second</title><link>https://example.com/2</link></item>
+</channel></rss>`,
+			want: feedItem{
+				Title:       "Acme: Backend Engineer",
+				Link:        "https://example.com/jobs/42",
+				GUID:        "acme-42",
+				Published:   time.Date(2025, 6, 2, 10, 0, 0, 0, time.UTC),
+				Description: "<p>Build APIs &amp; services</p>",
+				Categories:  []string{"Backend"},
+				Author:      "Acme",
+				Region:      "Anywhere in the World",
+			},
+			count: 2,
+		},
+		{
+			name: "rss 1.0",
+			feed: `<rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#" xmlns="http://purl.org/rss/1.0/" xmlns:dc="http://purl.org/dc/elements/1.1/">
+<channel><title>Jobs</title></channel>
+<item><title>Data Analyst</title><link>https://example.com/a</link><dc:date>2025-06-02</dc:date></item>
+</rdf:RDF>`,
+			want: feedItem{
+				Title:     "Data Analyst",
+				Link:      "https://example.com/a",
+				Published: time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC),
+			},
+			count: 1,
+		},
+		{
+			name: "atom",
+			feed: `<feed xmlns="http://www.w3.org/2005/Atom">
+<entry>
+  <title>Junior Developer at Beta</title>
+  <link rel="self" href="https://example.com/self"/>
+  <link href="https://example.com/jobs/7"/>
+  <id>urn:job:7</id>
+  <updated>2025-06-02T10:00:00Z</updated>
+  <summary>Short</summary>
+  <content type="html">A longer description</content>
+  <category term="eng" label="Engineering"/>
+  <author><name>Beta</name></author>
+</entry>
+</feed>`,
+			want: feedItem{
+				Title:       "Junior Developer at Beta",
+				Link:        "https://example.com/jobs/7",
+				GUID:        "urn:job:7",
+				Published:   time.Date(2025, 6, 2, 10, 0, 0, 0, time.UTC),
+				Description: "A longer description",
+				Categories:  []string{"Engineering"},
+				Author:      "Beta",
+			},
+			count: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		items, err := parseFeed([]byte(tt.feed), "https://example.com/feed.xml")
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if len(items) != tt.count {
+			t.Errorf("%s: got %d items, want %d", tt.name, len(items), tt.count)
+			continue
+		}
+		got := items[0]
+		if got.Title != tt.want.Title || got.Link != tt.want.Link || got.GUID != tt.want.GUID ||
+			got.Description != tt.want.Description || got.Author != tt.want.Author || got.Region != tt.want.Region {
+			t.Errorf("%s: got %+v, want %+v", tt.name, got, tt.want)
+		}
+		if !got.Published.Equal(tt.want.Published) {
+			t.Errorf("%s: published %v, want %v", tt.name, got.Published, tt.want.Published)
+		}
+		if len(got.Categories) != len(tt.want.Categories) || (len(got.Categories) > 0 && got.Categories[0] != tt.want.Categories[0]) {
+			t.Errorf("%s: categories %q, want %q", tt.name, got.Categories, tt.want.Categories)
+		}
+	}
+}
+
+func TestParseFeedRejectsHTML(t *testing.T) {
+	for _, body := range []string{
+		"<!DOCTYPE html><html><body>Just a moment...</body></html>",
+		`{"jobs": []}`,
+	} {
+		if _, err := parseFeed([]byte(body), ""); err == nil {
+			t.Errorf("parseFeed(%.20q) succeeded, want an error", body)
+		}
+	}
+}
+
+func TestSplitFeedTitle(t *testing.T) {
+	tests := []struct{ in, title, company string }{
+		{"Acme: Backend Engineer", "Backend Engineer", "Acme"},
+		{"Backend Engineer at Acme", "Backend Engineer", "Acme"},
+		{"Backend Engineer", "Backend Engineer", ""},
+	}
+	for _, tt := range tests {
+		title, company := splitFeedTitle(tt.in)
+		if title != tt.title || company != tt.company {
+			t.Errorf("splitFeedTitle(%q) = (%q, %q), want (%q, %q)", tt.in, title, company, tt.title, tt.company)
+		}
+	}
+}
+
+func TestFeedItemToJob(t *testing.T) {
+	item := feedItem{
+		Title:       "Acme: Junior Backend Engineer",
+		Link:        "https://example.com/jobs/42",
+		Description: "<p>Acme has 1000+ employees. 0-2 years of experience.</p>",
+		Region:      "Anywhere in the World",
+	}
+	job := feedItemToJob(FeedConfig{Name: "We Work Remotely", URL: "https://weworkremotely.com/remote-jobs.rss"}, item)
+	if job.Title != "Junior Backend Engineer" || job.Company != "Acme" || job.Location != "Anywhere in the World" {
+		t.Errorf("got title %q company %q location %q", job.Title, job.Company, job.Location)
+	}
+	if job.ExperienceMin != 0 || job.ExperienceMax != 2 {
+		t.Errorf("experience = (%d, %d), want (0, 2)", job.ExperienceMin, job.ExperienceMax)
+	}
+	if job.Description != "Acme has 1000+ employees. 0-2 years of experience." {
+		t.Errorf("description = %q", job.Description)
+	}
+}
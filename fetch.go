@@ -1,35 +1,18 @@
 package main
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
-	"net/http"
 	"time"
 )
 
-type Job struct {
-	ID     string    `json:"id"`
-	Title  string    `json:"title"`
-	Link   string    `json:"link"`
-	Source string    `json:"source,omitempty"`
-	Date   time.Time `json:"date,omitempty"` // For date filtering
+func init() {
+	registerSource(newSource("RemoteOK", "remoteok", fetchJobs))
 }
 
-func fetchJobs() ([]Job, error) {
-	req, _ := http.NewRequest("GET", "https://remoteok.com/api", nil)
-	req.Header.Set("User-Agent", "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7)")
-
-	client := &http.Client{
-		Timeout: 15 * time.Second, // Reduced from 30s to 15s
-	}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
+func fetchJobs(ctx context.Context) ([]Job, error) {
 	var raw []map[string]interface{}
-	if err := json.NewDecoder(resp.Body).Decode(&raw); err != nil {
+	if err := getJSON(ctx, "https://remoteok.com/api", &raw); err != nil {
 		return nil, err
 	}
 
@@ -61,12 +44,31 @@ func fetchJobs() ([]Job, error) {
 			link = "https://remoteok.com" + urlPath
 		}
 
+		company, _ := j["company"].(string)
+		location, _ := j["location"].(string)
+		description, _ := j["description"].(string)
+
+		var tags []string
+		if rawTags, ok := j["tags"].([]interface{}); ok {
+			for _, t := range rawTags {
+				if tag, ok := t.(string); ok {
+					tags = append(tags, tag)
+				}
+			}
+		}
+
 		jobs = append(jobs, Job{
-			ID:     "remoteok-" + id,
-			Title:  j["position"].(string),
-			Link:   link,
-			Source: "RemoteOK",
-			Date:   parseRemoteOKDate(j["date"]),
+			ID:          makeJobID("remoteok", id, link),
+			Title:       j["position"].(string),
+			Link:        link,
+			Source:      "RemoteOK",
+			Company:     company,
+			Location:    location,
+			WorkMode:    WorkModeRemote, // Remote-only board
+			Description: htmlToText(description),
+			Salary:      parseRemoteOKSalary(j["salary_min"], j["salary_max"]),
+			Tags:        tags,
+			Date:        parseRemoteOKDate(j["date"]),
 		})
 	}
 
@@ -86,3 +88,16 @@ func parseRemoteOKDate(v interface{}) time.Time {
 	}
 	return time.Time{} // Zero value if parsing fails
 }
+
+// parseRemoteOKSalary formats the yearly USD range, empty if not listed
+func parseRemoteOKSalary(minV, maxV interface{}) string {
+	min, _ := minV.(float64)
+	max, _ := maxV.(float64)
+	switch {
+	case min > 0 && max > 0:
+		return fmt.Sprintf("$%.0f - $%.0f", min, max)
+	case min > 0:
+		return fmt.Sprintf("$%.0f+", min)
+	}
+	return ""
+}
@@ -22,6 +22,15 @@ var expPatterns = []*regexp.Regexp{
 	regexp.MustCompile(`(\d+)\s*-\s*\d+\s*(?:years?|yrs?)`),
 }
 
+// Descriptions only count years tied to the word experience, so "founded 12
+// years ago" or "vests over 4 years" don't reject a job
+var descriptionExpPatterns = []*regexp.Regexp{
+	// "3+ years of experience", "2-4 years of relevant work experience"
+	regexp.MustCompile(`(\d+)\s*\+?\s*(?:(?:-|to|–)\s*\d+\s*)?(?:years?|yrs?)(?:\s+of)?(?:\s+[a-z-]+){0,3}?\s+(?:experience|exp)\b`),
+	// "Experience: 2 years", "experience of at least 3 yrs"
+	regexp.MustCompile(`(?:experience|exp)\s*(?:required|needed)?\s*[:\-–]?\s*(?:of\s+)?(?:at\s+least\s+|minimum\s+)?(\d+)\s*\+?\s*(?:(?:-|to|–)\s*\d+\s*)?(?:years?|yrs?)\b`),
+}
+
 func initFilters(cfg Config) {
 	keywords = cfg.Keywords
 	locations = cfg.Locations
@@ -72,11 +81,20 @@ func hasExcludedKeyword(title string) bool {
 	return false
 }
 
-// extractExperience extracts required years from text
+// extractExperience extracts required years from short text such as a title
 func extractExperience(text string) int {
+	return matchExperience(expPatterns, text)
+}
+
+// extractDescriptionExperience extracts required years from a full posting
+func extractDescriptionExperience(text string) int {
+	return matchExperience(descriptionExpPatterns, text)
+}
+
+func matchExperience(patterns []*regexp.Regexp, text string) int {
 	text = strings.ToLower(text)
 
-	for _, pattern := range expPatterns {
+	for _, pattern := range patterns {
 		matches := pattern.FindStringSubmatch(text)
 		if len(matches) > 1 {
 			// Parse the first number found
@@ -90,8 +108,6 @@ func extractExperience(text string) int {
 
 // isEligibleJob checks all filters
 func isEligibleJob(job Job) bool {
-	combined := strings.ToLower(job.Title + " " + job.Link)
-
 	// Must match at least one keyword
 	if !matchesKeyword(job.Title) {
 		return false
@@ -107,23 +123,30 @@ func isEligibleJob(job Job) bool {
 		return false
 	}
 
-	// Check experience requirement if detectable
-	expYears := extractExperience(combined)
+	// Prefer the range the source gave us, otherwise look for one in the text
+	expYears := job.ExperienceMin
+	if !job.hasExperience() {
+		expYears = extractExperience(job.Title)
+		if expYears == 0 {
+			expYears = extractDescriptionExperience(job.Description)
+		}
+	}
 	if expYears > maxExpYears {
 		return false
 	}
 
-	// Location check - RemoteOK jobs are remote by default
-	if job.Source == "RemoteOK" {
+	// Location check - remote jobs need no location match
+	if job.Source == "RemoteOK" || job.WorkMode == WorkModeRemote {
 		return true
 	}
 
-	// For other sources, check location in title/link
-	if !matchesLocation(combined) {
-		return false
+	// Use the structured location when the source provides one
+	if job.Location != "" {
+		return matchesLocation(job.Location)
 	}
 
-	return true
+	// Otherwise fall back to whatever the title and link mention
+	return matchesLocation(job.Title + " " + job.Link)
 }
 
 // isRecentJob checks if job is within maxDaysOld
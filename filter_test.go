@@ -0,0 +1,65 @@
+package main
+
+import "testing"
+
+func TestExtractExperience(t *testing.T) {
+	tests := []struct {
+		title string
+		want  int
+	}{
+		{"Junior Engineer (1 year experience)", 1},
+		{"Backend Developer - 3+ yrs", 3},
+		{"Data Analyst, 5 years experience", 5},
+		{"Software Engineer", 0},
+	}
+	for _, tt := range tests {
+		if got := extractExperience(tt.title); got != tt.want {
+			t.Errorf("extractExperience(%q) = %d, want %d", tt.title, got, tt.want)
+		}
+	}
+}
+
+func TestExtractDescriptionExperience(t *testing.T) {
+	tests := []struct {
+		description string
+		want        int
+	}{
+		{"What you'll need: 3+ years of experience building REST APIs in Go.", 3},
+		{"You have 2-4 years of relevant work experience with React.", 2},
+		{"Experience: 5 years. Location: Bengaluru.", 5},
+		{"Experience required - 1 to 3 yrs in backend development.", 1},
+		{"We're looking for someone with experience of at least 4 years in SQL.", 4},
+		{"Founded 12 years ago, Acme serves 2 million merchants across India.", 0},
+		{"Your ESOPs vest over 4 years with a 1 year cliff.", 0},
+		{"We have been building payments for 10 years. Experience with Kafka is a plus.", 0},
+		{"Open to freshers. Experience with Python or Java preferred.", 0},
+		{"", 0},
+	}
+	for _, tt := range tests {
+		if got := extractDescriptionExperience(tt.description); got != tt.want {
+			t.Errorf("extractDescriptionExperience(%q) = %d, want %d", tt.description, got, tt.want)
+		}
+	}
+}
+
+func TestIsEligibleJobDescriptionExperience(t *testing.T) {
+	keywords, excludeKeywords, locations, maxExpYears, maxDaysOld = []string{"engineer"}, []string{"senior"}, nil, 2, 0
+
+	job := Job{
+		Title:       "Software Engineer",
+		Description: "Founded 12 years ago, we're a team of 1000+ people. Your stock vests over 4 years.",
+	}
+	if !isEligibleJob(job) {
+		t.Errorf("company boilerplate rejected the job")
+	}
+
+	job.Description = "You'll need 5+ years of experience running Kubernetes."
+	if isEligibleJob(job) {
+		t.Errorf("a posting asking for 5+ years was kept")
+	}
+
+	job.ExperienceMin, job.ExperienceMax = 0, 1
+	if !isEligibleJob(job) {
+		t.Errorf("the source's own range 0-1 was ignored in favour of the description")
+	}
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"time"
+)
+
+// ================== GREENHOUSE ==================
+// Public job board API: boards-api.greenhouse.io/v1/boards/{token}/jobs
+
+func init() {
+	registerATS("greenhouse", fetchGreenhouseJobs)
+}
+
+type greenhouseResponse struct {
+	Jobs []struct {
+		ID             int64  `json:"id"`
+		Title          string `json:"title"`
+		AbsoluteURL    string `json:"absolute_url"`
+		UpdatedAt      string `json:"updated_at"`
+		FirstPublished string `json:"first_published"`
+		Content        string `json:"content"` // HTML, entity-escaped
+		Location       struct {
+			Name string `json:"name"`
+		} `json:"location"`
+		Departments []struct {
+			Name string `json:"name"`
+		} `json:"departments"`
+	} `json:"jobs"`
+}
+
+// fetchGreenhouseJobs reads every open posting on a company's Greenhouse board.
+// company.Token is the board token, e.g. "stripe" for boards.greenhouse.io/stripe.
+func fetchGreenhouseJobs(ctx context.Context, company CompanyCareer) ([]Job, error) {
+	if company.Token == "" {
+		return nil, fmt.Errorf("greenhouse: no board token for %s", company.Name)
+	}
+
+	url := fmt.Sprintf("https://boards-api.greenhouse.io/v1/boards/%s/jobs?content=true", company.Token)
+
+	var data greenhouseResponse
+	if err := getJSON(ctx, url, &data); err != nil {
+		return nil, fmt.Errorf("greenhouse %s: %w", company.Token, err)
+	}
+
+	var jobs []Job
+	for _, j := range data.Jobs {
+		if !isEntryLevelJob(j.Title) {
+			continue
+		}
+
+		var departments []string
+		for _, d := range j.Departments {
+			departments = append(departments, d.Name)
+		}
+
+		// first_published is when it went live; older boards only have updated_at
+		date := parseGreenhouseTime(j.FirstPublished)
+		if date.IsZero() {
+			date = parseGreenhouseTime(j.UpdatedAt)
+		}
+
+		jobs = append(jobs, Job{
+			ID:          makeJobID(companySlug(company.Name), fmt.Sprintf("%d", j.ID), j.AbsoluteURL),
+			Title:       j.Title,
+			Link:        j.AbsoluteURL,
+			Source:      company.Name,
+			Company:     company.Name,
+			Location:    j.Location.Name,
+			WorkMode:    detectWorkMode(j.Location.Name),
+			Description: htmlToText(html.UnescapeString(j.Content)),
+			Tags:        departments,
+			Date:        date,
+		})
+	}
+
+	return jobs, nil
+}
+
+func parseGreenhouseTime(s string) time.Time {
+	if s == "" {
+		return time.Time{}
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
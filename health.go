@@ -0,0 +1,471 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+	"unicode/utf8"
+)
+
+// ================== SOURCE HEALTH ==================
+// Every source and company page gets a health record each run: consecutive
+// failures, runs in a row without jobs, the last success and the last HTTP
+// status. An entry that keeps failing trips a circuit breaker and is skipped
+// until a re-probe is due; each failed probe doubles the wait for the next.
+//
+// Scrapers whose markup changed usually don't fail, they just find nothing.
+// So each successful result is also compared with the entry's baseline (the
+// median of its recent normal counts), and results that look broken are sent
+// to the admin chat once, naming the last good run.
+
+// HealthConfig is the `health:` block in config.yaml
+type HealthConfig struct {
+	Enabled          bool    `yaml:"enabled"`           // Skip entries whose breaker is open; health is tracked either way
+	FailureThreshold int     `yaml:"failure_threshold"` // Consecutive errors that open the breaker
+	ZeroThreshold    int     `yaml:"zero_threshold"`    // Consecutive runs with no jobs that open it; 0 never
+	BackoffHours     float64 `yaml:"backoff_hours"`     // Wait before the first re-probe
+	MaxBackoffHours  float64 `yaml:"max_backoff_hours"` // Cap on the doubling wait
+	BaselineRuns     int     `yaml:"baseline_runs"`     // Normal runs the baseline is the median of
+	MinBaseline      int     `yaml:"min_baseline"`      // Entries with a smaller baseline aren't checked for drops
+	DropRatio        float64 `yaml:"drop_ratio"`        // Fewer than baseline*drop_ratio jobs is an anomaly
+}
+
+const (
+	defaultHealthFailures   = 5
+	defaultHealthBackoff    = 6.0
+	defaultHealthMaxBackoff = 168.0 // A week
+	defaultBaselineRuns     = 24
+	defaultMinBaseline      = 5
+	defaultDropRatio        = 0.2
+	minBaselineRuns         = 3 // A baseline from fewer runs is too noisy to judge by
+)
+
+// HealthRecord is the history of one source ("source:linkedin") or company
+// page ("company:Stripe")
+type HealthRecord struct {
+	Kind                string `json:"kind"` // "source" or "company"
+	Name                string `json:"name"`
+	ConsecutiveFailures int    `json:"consecutive_failures"`
+	ZeroStreak          int    `json:"zero_streak"`            // Successful runs in a row that returned no jobs
+	LastJobs            int    `json:"last_jobs"`              // Jobs returned by the latest run
+	LastStatus          int    `json:"last_status,omitempty"`  // HTTP status of the latest response
+	LastError           string `json:"last_error,omitempty"`   // Empty after a success
+	LastSuccess         int64  `json:"last_success,omitempty"` // Unix time of the latest run without an error
+	LastChecked         int64  `json:"last_checked"`
+	OpenUntil           int64  `json:"open_until,omitempty"`     // Breaker open: skipped until this Unix time; 0 is closed
+	Trips               int    `json:"trips,omitempty"`          // Times opened in a row; sets the backoff
+	RecentJobs          []int  `json:"recent_jobs,omitempty"`    // Job counts of recent normal runs, oldest first
+	LastGoodRun         int64  `json:"last_good_run,omitempty"`  // Unix time of the latest run with a normal count
+	LastGoodJobs        int    `json:"last_good_jobs,omitempty"` // Jobs that run returned
+	Anomaly             string `json:"anomaly,omitempty"`        // Why the latest result looks broken; empty when normal
+}
+
+// healthAlert is an entry whose results started or stopped looking broken
+type healthAlert struct {
+	Record    HealthRecord
+	Recovered bool
+}
+
+// HealthTracker holds this run's view of every entry's health. A nil
+// *HealthTracker runs everything and records nothing.
+type HealthTracker struct {
+	cfg     HealthConfig
+	mu      sync.Mutex
+	records map[string]HealthRecord
+	alerts  []healthAlert // This run's, for the admin message
+}
+
+// sourceHealth is set by main on every run
+var sourceHealth *HealthTracker
+
+func newHealthTracker(c HealthConfig, records map[string]HealthRecord) *HealthTracker {
+	if c.FailureThreshold <= 0 {
+		c.FailureThreshold = defaultHealthFailures
+	}
+	if c.BackoffHours <= 0 {
+		c.BackoffHours = defaultHealthBackoff
+	}
+	if c.MaxBackoffHours <= 0 {
+		c.MaxBackoffHours = defaultHealthMaxBackoff
+	}
+	if c.BaselineRuns <= 0 {
+		c.BaselineRuns = defaultBaselineRuns
+	}
+	if c.MinBaseline <= 0 {
+		c.MinBaseline = defaultMinBaseline
+	}
+	if c.DropRatio <= 0 {
+		c.DropRatio = defaultDropRatio
+	}
+	if records == nil {
+		records = map[string]HealthRecord{}
+	}
+	return &HealthTracker{cfg: c, records: records}
+}
+
+func healthKey(kind, name string) string {
+	return kind + ":" + name
+}
+
+// allow reports whether an entry should run. An open breaker whose backoff
+// has passed lets one probe through; its result closes or reopens it.
+func (t *HealthTracker) allow(kind, name string, now time.Time) bool {
+	if t == nil {
+		return true
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	r := t.records[healthKey(kind, name)]
+	return !t.cfg.Enabled || r.OpenUntil == 0 || now.Unix() >= r.OpenUntil
+}
+
+// record adds one run's outcome to an entry's history, checks a successful
+// result against the baseline and opens or closes the breaker
+func (t *HealthTracker) record(kind, name string, jobs []Job, status int, err error, now time.Time) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	key := healthKey(kind, name)
+	r := t.records[key]
+	r.Kind, r.Name = kind, name
+	r.LastChecked = now.Unix()
+	r.LastJobs = len(jobs)
+	r.LastStatus = status
+
+	if err != nil {
+		r.ConsecutiveFailures++
+		r.LastError = truncateRunes(err.Error(), 200)
+	} else {
+		r.ConsecutiveFailures = 0
+		r.LastError = ""
+		r.LastSuccess = now.Unix()
+		if len(jobs) == 0 {
+			r.ZeroStreak++
+		} else {
+			r.ZeroStreak = 0
+		}
+		t.checkResult(&r, jobs, now)
+	}
+
+	failing := r.ConsecutiveFailures >= t.cfg.FailureThreshold ||
+		(t.cfg.ZeroThreshold > 0 && r.ZeroStreak >= t.cfg.ZeroThreshold)
+
+	switch {
+	case !failing || !t.cfg.Enabled:
+		if r.OpenUntil != 0 {
+			fmt.Printf("    🔌 %s recovered; no longer skipped\n", name)
+		}
+		r.OpenUntil, r.Trips = 0, 0
+	case r.OpenUntil == 0 || now.Unix() >= r.OpenUntil: // Just crossed the threshold, or a probe failed
+		r.Trips++
+		r.OpenUntil = now.Add(t.backoff(r.Trips)).Unix()
+		fmt.Printf("    🔌 %s keeps failing; skipped until %s\n", name, time.Unix(r.OpenUntil, 0).UTC().Format("Jan 2 15:04 MST"))
+	}
+
+	t.records[key] = r
+}
+
+// checkResult compares a successful result with the entry's baseline. Only
+// normal counts join the baseline, so a broken scraper can't drag it down
+// until its zeros look normal.
+func (t *HealthTracker) checkResult(r *HealthRecord, jobs []Job, now time.Time) {
+	anomaly := t.resultAnomaly(*r, jobs)
+
+	switch {
+	case anomaly != "" && r.Anomaly == "":
+		fmt.Printf("    ⚠️  %s: %s\n", r.Name, anomaly)
+		r.Anomaly = anomaly
+		t.alerts = append(t.alerts, healthAlert{Record: *r})
+	case anomaly == "" && r.Anomaly != "":
+		r.Anomaly = ""
+		t.alerts = append(t.alerts, healthAlert{Record: *r, Recovered: true})
+	default:
+		r.Anomaly = anomaly // Still broken: keep the latest reason, alerted already
+	}
+	if anomaly != "" {
+		return
+	}
+
+	r.RecentJobs = append(r.RecentJobs, len(jobs))
+	if extra := len(r.RecentJobs) - t.cfg.BaselineRuns; extra > 0 {
+		r.RecentJobs = r.RecentJobs[extra:]
+	}
+	if len(jobs) > 0 {
+		r.LastGoodRun, r.LastGoodJobs = now.Unix(), len(jobs)
+	}
+}
+
+// resultAnomaly says why a result looks like broken scraping, or "" if it
+// looks normal: most jobs sharing one link (a selector matching the same
+// button everywhere), or no jobs or a sharp drop against the baseline
+func (t *HealthTracker) resultAnomaly(r HealthRecord, jobs []Job) string {
+	if len(jobs) >= 3 {
+		links := map[string]int{}
+		top := 0
+		for _, j := range jobs {
+			if j.Link != "" {
+				links[j.Link]++
+				top = max(top, links[j.Link])
+			}
+		}
+		if top*2 > len(jobs) {
+			return fmt.Sprintf("%d of %d jobs share one link", top, len(jobs))
+		}
+	}
+
+	if len(r.RecentJobs) < minBaselineRuns {
+		return ""
+	}
+	baseline := medianInt(r.RecentJobs)
+	if baseline < t.cfg.MinBaseline {
+		return ""
+	}
+	switch {
+	case len(jobs) == 0:
+		return fmt.Sprintf("no jobs, usually about %d", baseline)
+	case float64(len(jobs)) < float64(baseline)*t.cfg.DropRatio:
+		return fmt.Sprintf("%d jobs, usually about %d", len(jobs), baseline)
+	}
+	return ""
+}
+
+func medianInt(values []int) int {
+	sorted := append([]int(nil), values...)
+	sort.Ints(sorted)
+	return sorted[len(sorted)/2]
+}
+
+// anomalyReport is the admin message for entries whose results started or
+// stopped looking broken this run, or "" if there are none
+func (t *HealthTracker) anomalyReport() string {
+	if t == nil {
+		return ""
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if len(t.alerts) == 0 {
+		return ""
+	}
+
+	alerts := append([]healthAlert(nil), t.alerts...)
+	sort.Slice(alerts, func(i, k int) bool {
+		if alerts[i].Recovered != alerts[k].Recovered {
+			return !alerts[i].Recovered
+		}
+		return strings.ToLower(alerts[i].Record.Name) < strings.ToLower(alerts[k].Record.Name)
+	})
+
+	var b strings.Builder
+	b.WriteString("🛠️ Source check\n\n")
+	for _, a := range alerts {
+		r := a.Record
+		if a.Recovered {
+			fmt.Fprintf(&b, "✅ %s (%s): back to normal, %d jobs\n", r.Name, r.Kind, r.LastJobs)
+			continue
+		}
+		lastGood := "no good run on record"
+		if r.LastGoodRun != 0 {
+			lastGood = fmt.Sprintf("last good run %s with %d jobs",
+				time.Unix(r.LastGoodRun, 0).UTC().Format("Jan 2 15:04 MST"), r.LastGoodJobs)
+		}
+		fmt.Fprintf(&b, "• %s (%s): %s; %s\n", r.Name, r.Kind, r.Anomaly, lastGood)
+	}
+	return b.String()
+}
+
+// backoff is the wait before re-probing after the breaker opened trips times
+func (t *HealthTracker) backoff(trips int) time.Duration {
+	hours := math.Min(t.cfg.BackoffHours*math.Pow(2, float64(trips-1)), t.cfg.MaxBackoffHours)
+	return time.Duration(hours * float64(time.Hour))
+}
+
+// filterSources drops sources whose breaker is open
+func (t *HealthTracker) filterSources(sources []Source, now time.Time) []Source {
+	var allowed []Source
+	for _, s := range sources {
+		if t.allow("source", s.ConfigKey(), now) {
+			allowed = append(allowed, s)
+		} else {
+			fmt.Printf("  ⏸️  %s: skipped while failing (go run . health)\n", s.Name())
+		}
+	}
+	return allowed
+}
+
+// snapshot returns the records to save, without entries not checked since
+// cutoff (removed companies, disabled sources)
+func (t *HealthTracker) snapshot(cutoff time.Time) map[string]HealthRecord {
+	if t == nil {
+		return nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	out := make(map[string]HealthRecord, len(t.records))
+	for key, r := range t.records {
+		if r.LastChecked >= cutoff.Unix() {
+			out[key] = r
+		}
+	}
+	return out
+}
+
+// recordSourceResults adds the sources' outcomes to their health. When the
+// fetch stage ran out of time, errors and empty results aren't held against
+// the sources that were cut off.
+func (t *HealthTracker) recordSourceResults(results []SourceResult, timedOut bool, now time.Time) {
+	for _, r := range results {
+		if timedOut && (r.Err != nil || len(r.Jobs) == 0) {
+			continue
+		}
+		t.record("source", r.Source.ConfigKey(), r.Jobs, r.HTTPStatus, r.Err, now)
+	}
+}
+
+// ================== STATUS TRACE ==================
+// The fetcher notes each response's status in the request's context, so
+// health tracking learns a source's last HTTP status without the source
+// passing it back
+
+type statusTrace struct {
+	mu     sync.Mutex
+	status int
+}
+
+type statusTraceKey struct{}
+
+// withStatusTrace returns a context whose requests record their status in the trace
+func withStatusTrace(ctx context.Context) (context.Context, *statusTrace) {
+	t := &statusTrace{}
+	return context.WithValue(ctx, statusTraceKey{}, t), t
+}
+
+// traceStatus records status if ctx carries a trace
+func traceStatus(ctx context.Context, status int) {
+	if t, ok := ctx.Value(statusTraceKey{}).(*statusTrace); ok {
+		t.mu.Lock()
+		t.status = status
+		t.mu.Unlock()
+	}
+}
+
+func (t *statusTrace) last() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.status
+}
+
+// ================== HEALTH COMMAND ==================
+
+// runHealthCommand implements `go run . health`: every tracked entry, the
+// skipped, failing and suspect ones first
+func runHealthCommand(storage StorageConfig) error {
+	records, err := loadHealthHistory(storage)
+	if err != nil {
+		return err
+	}
+	if len(records) == 0 {
+		fmt.Println("No health history yet; it is recorded on every run.")
+		return nil
+	}
+
+	list := make([]HealthRecord, 0, len(records))
+	for _, r := range records {
+		list = append(list, r)
+	}
+	sort.Slice(list, func(i, k int) bool {
+		a, b := list[i], list[k]
+		if (a.OpenUntil != 0) != (b.OpenUntil != 0) {
+			return a.OpenUntil != 0
+		}
+		if a.ConsecutiveFailures != b.ConsecutiveFailures {
+			return a.ConsecutiveFailures > b.ConsecutiveFailures
+		}
+		if (a.Anomaly != "") != (b.Anomaly != "") {
+			return a.Anomaly != ""
+		}
+		if a.ZeroStreak != b.ZeroStreak {
+			return a.ZeroStreak > b.ZeroStreak
+		}
+		if a.Kind != b.Kind {
+			return a.Kind > b.Kind // Sources before companies
+		}
+		return strings.ToLower(a.Name) < strings.ToLower(b.Name)
+	})
+
+	now := time.Now()
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "STATE\tKIND\tNAME\tFAILS\tEMPTY RUNS\tJOBS\tBASELINE\tHTTP\tLAST SUCCESS\tPROBLEM")
+	for _, r := range list {
+		status := "-"
+		if r.LastStatus != 0 {
+			status = fmt.Sprintf("%d", r.LastStatus)
+		}
+		baseline := "-"
+		if len(r.RecentJobs) >= minBaselineRuns {
+			baseline = fmt.Sprintf("%d", medianInt(r.RecentJobs))
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%d\t%d\t%s\t%s\t%s\t%s\n",
+			healthState(r, now), r.Kind, r.Name, r.ConsecutiveFailures, r.ZeroStreak, r.LastJobs,
+			baseline, status, healthAge(r.LastSuccess, now), shortError(firstNonEmpty(r.LastError, r.Anomaly)))
+	}
+	return w.Flush()
+}
+
+func healthState(r HealthRecord, now time.Time) string {
+	switch {
+	case r.OpenUntil != 0 && now.Unix() < r.OpenUntil:
+		return "skipped for " + shortDuration(time.Unix(r.OpenUntil, 0).Sub(now))
+	case r.OpenUntil != 0:
+		return "re-probe due"
+	case r.ConsecutiveFailures > 0:
+		return "failing"
+	case r.Anomaly != "":
+		return "suspect"
+	case r.ZeroStreak > 0:
+		return "empty"
+	}
+	return "ok"
+}
+
+// healthAge formats a Unix time as "3h ago"
+func healthAge(unix int64, now time.Time) string {
+	if unix == 0 {
+		return "never"
+	}
+	return shortDuration(now.Sub(time.Unix(unix, 0))) + " ago"
+}
+
+func shortDuration(d time.Duration) string {
+	switch {
+	case d < time.Hour:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	case d < 48*time.Hour:
+		return fmt.Sprintf("%dh", int(d.Hours()))
+	}
+	return fmt.Sprintf("%dd", int(d.Hours()/24))
+}
+
+func shortError(s string) string {
+	if utf8.RuneCountInString(s) > 60 {
+		return truncateRunes(s, 57) + "..."
+	}
+	return s
+}
+
+// truncateRunes cuts s to at most n characters without splitting one
+func truncateRunes(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return string([]rune(s)[:n])
+}
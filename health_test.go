@@ -0,0 +1,213 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+// healthJobs returns n jobs with distinct links
+func healthJobs(n int) []Job {
+	jobs := make([]Job, n)
+	for i := range jobs {
+		jobs[i] = Job{Link: fmt.Sprintf("https://example.com/jobs/%d", i)}
+	}
+	return jobs
+}
+
+func TestHealthBreaker(t *testing.T) {
+	tracker := newHealthTracker(HealthConfig{Enabled: true, FailureThreshold: 2, BackoffHours: 1, MaxBackoffHours: 3}, nil)
+	start := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	fail := errors.New("HTTP 503")
+
+	steps := []struct {
+		name      string
+		at        time.Duration // since start
+		err       error
+		wantAllow bool // before recording this step
+		wantOpen  time.Duration
+		wantTrips int
+	}{
+		{name: "first failure", at: 0, err: fail, wantAllow: true},
+		{name: "threshold opens", at: time.Minute, err: fail, wantAllow: true, wantOpen: time.Minute + time.Hour, wantTrips: 1},
+		{name: "failed probe doubles", at: 2 * time.Hour, err: fail, wantAllow: true, wantOpen: 4 * time.Hour, wantTrips: 2},
+		{name: "backoff is capped", at: 4 * time.Hour, err: fail, wantAllow: true, wantOpen: 7 * time.Hour, wantTrips: 3},
+		{name: "successful probe closes", at: 7 * time.Hour, wantAllow: true},
+		{name: "one failure after closing", at: 8 * time.Hour, err: fail, wantAllow: true},
+	}
+
+	for _, s := range steps {
+		now := start.Add(s.at)
+		if got := tracker.allow("source", "indeed", now); got != s.wantAllow {
+			t.Errorf("%s: allow() = %v, want %v", s.name, got, s.wantAllow)
+		}
+
+		var jobs []Job
+		if s.err == nil {
+			jobs = healthJobs(1)
+		}
+		tracker.record("source", "indeed", jobs, 0, s.err, now)
+
+		r := tracker.records[healthKey("source", "indeed")]
+		var wantOpen int64
+		if s.wantOpen != 0 {
+			wantOpen = start.Add(s.wantOpen).Unix()
+		}
+		if r.OpenUntil != wantOpen || r.Trips != s.wantTrips {
+			t.Errorf("%s: OpenUntil, Trips = %d, %d, want %d, %d", s.name, r.OpenUntil, r.Trips, wantOpen, s.wantTrips)
+		}
+		if s.wantOpen != 0 && tracker.allow("source", "indeed", now.Add(time.Second)) {
+			t.Errorf("%s: allow() just after opening = true, want false", s.name)
+		}
+	}
+}
+
+func TestHealthZeroStreakOpensBreaker(t *testing.T) {
+	tracker := newHealthTracker(HealthConfig{Enabled: true, ZeroThreshold: 3, BackoffHours: 1}, nil)
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	for i := 0; i < 3; i++ {
+		tracker.record("company", "Acme", nil, 200, nil, now)
+	}
+	if tracker.allow("company", "Acme", now) {
+		t.Error("allow() after 3 empty runs = true, want false")
+	}
+
+	tracker.record("company", "Acme", healthJobs(2), 200, nil, now.Add(time.Hour))
+	if r := tracker.records[healthKey("company", "Acme")]; r.OpenUntil != 0 || r.ZeroStreak != 0 {
+		t.Errorf("after jobs: OpenUntil = %d, ZeroStreak = %d, want both 0", r.OpenUntil, r.ZeroStreak)
+	}
+}
+
+func TestHealthDisabledStillChecksAnomalies(t *testing.T) {
+	tracker := newHealthTracker(HealthConfig{FailureThreshold: 1, ZeroThreshold: 1}, nil)
+	start := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	for i := 0; i < 3; i++ {
+		tracker.record("company", "Acme", healthJobs(10), 200, nil, start.Add(time.Duration(i)*time.Hour))
+	}
+	tracker.record("company", "Acme", nil, 200, nil, start.Add(3*time.Hour))
+
+	if !tracker.allow("company", "Acme", start.Add(4*time.Hour)) {
+		t.Error("allow() with health disabled = false, want true")
+	}
+	if r := tracker.records[healthKey("company", "Acme")]; r.OpenUntil != 0 || r.ZeroStreak != 1 {
+		t.Errorf("OpenUntil = %d, ZeroStreak = %d, want 0 and 1", r.OpenUntil, r.ZeroStreak)
+	}
+	if report := tracker.anomalyReport(); !strings.Contains(report, "Acme (company): no jobs, usually about 10") {
+		t.Errorf("anomalyReport() = %q, want the empty run reported", report)
+	}
+}
+
+func TestResultAnomaly(t *testing.T) {
+	tracker := newHealthTracker(HealthConfig{MinBaseline: 5, DropRatio: 0.2}, nil)
+
+	sameLink := healthJobs(4)
+	for i := range sameLink[:3] {
+		sameLink[i].Link = "https://example.com/apply"
+	}
+
+	tests := []struct {
+		name   string
+		recent []int
+		jobs   []Job
+		want   string
+	}{
+		{name: "no baseline yet", recent: []int{20, 20}, jobs: nil, want: ""},
+		{name: "normal count", recent: []int{20, 22, 18}, jobs: healthJobs(19), want: ""},
+		{name: "no jobs against baseline", recent: []int{20, 22, 18}, jobs: nil, want: "no jobs, usually about 20"},
+		{name: "sharp drop", recent: []int{20, 22, 18}, jobs: healthJobs(3), want: "3 jobs, usually about 20"},
+		{name: "small baseline not checked", recent: []int{2, 3, 4}, jobs: nil, want: ""},
+		{name: "most jobs share a link", recent: nil, jobs: sameLink, want: "3 of 4 jobs share one link"},
+		{name: "two jobs sharing a link", recent: nil, jobs: []Job{{Link: "x"}, {Link: "x"}}, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tracker.resultAnomaly(HealthRecord{RecentJobs: tt.recent}, tt.jobs); got != tt.want {
+				t.Errorf("resultAnomaly() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHealthAnomalyAlerts(t *testing.T) {
+	tracker := newHealthTracker(HealthConfig{BaselineRuns: 4}, nil)
+	start := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	for i := 0; i < 5; i++ {
+		tracker.record("company", "Acme", healthJobs(10), 200, nil, start.Add(time.Duration(i)*time.Hour))
+	}
+	r := tracker.records[healthKey("company", "Acme")]
+	if len(r.RecentJobs) != 4 || r.LastGoodJobs != 10 || r.LastGoodRun != start.Add(4*time.Hour).Unix() {
+		t.Fatalf("baseline = %+v, want 4 recent runs and the last good run at hour 4", r)
+	}
+	if report := tracker.anomalyReport(); report != "" {
+		t.Errorf("anomalyReport() with normal runs = %q, want empty", report)
+	}
+
+	// Broken twice: alerted once, and the zeros stay out of the baseline
+	tracker.record("company", "Acme", nil, 200, nil, start.Add(5*time.Hour))
+	tracker.record("company", "Acme", nil, 200, nil, start.Add(6*time.Hour))
+	r = tracker.records[healthKey("company", "Acme")]
+	if r.Anomaly != "no jobs, usually about 10" || len(r.RecentJobs) != 4 {
+		t.Errorf("after empty runs: Anomaly = %q, RecentJobs = %v", r.Anomaly, r.RecentJobs)
+	}
+	if len(tracker.alerts) != 1 {
+		t.Fatalf("alerts after two broken runs = %d, want 1", len(tracker.alerts))
+	}
+
+	tracker.record("company", "Acme", healthJobs(9), 200, nil, start.Add(7*time.Hour))
+	if r = tracker.records[healthKey("company", "Acme")]; r.Anomaly != "" {
+		t.Errorf("after recovery: Anomaly = %q, want empty", r.Anomaly)
+	}
+
+	report := tracker.anomalyReport()
+	broken := strings.Index(report, "• Acme (company): no jobs, usually about 10; last good run Jun 1 04:00 UTC with 10 jobs")
+	recovered := strings.Index(report, "✅ Acme (company): back to normal, 9 jobs")
+	if broken < 0 || recovered < 0 || recovered < broken {
+		t.Errorf("anomalyReport() = %q, want the broken line before the recovery line", report)
+	}
+}
+
+func TestHealthErrorTruncation(t *testing.T) {
+	tracker := newHealthTracker(HealthConfig{}, nil)
+	// Two-byte characters, so a byte cut at an even length would still be valid
+	long := "x" + strings.Repeat("é", 300)
+	tracker.record("company", "Acme", nil, 0, errors.New(long), time.Now())
+
+	got := tracker.records[healthKey("company", "Acme")].LastError
+	if !utf8.ValidString(got) || utf8.RuneCountInString(got) != 200 {
+		t.Errorf("LastError = %d runes (valid %v), want 200 valid runes", utf8.RuneCountInString(got), utf8.ValidString(got))
+	}
+
+	tests := []struct{ in, want string }{
+		{"timeout", "timeout"},
+		{strings.Repeat("ü", 60), strings.Repeat("ü", 60)},
+		{"x" + strings.Repeat("ü", 60), "x" + strings.Repeat("ü", 56) + "..."},
+	}
+	for _, tt := range tests {
+		if got := shortError(tt.in); got != tt.want {
+			t.Errorf("shortError(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestNilHealthTracker(t *testing.T) {
+	var tracker *HealthTracker
+	now := time.Now()
+
+	if !tracker.allow("source", "indeed", now) {
+		t.Error("nil tracker allow() = false, want true")
+	}
+	tracker.record("source", "indeed", nil, 0, errors.New("boom"), now)
+	if report := tracker.anomalyReport(); report != "" {
+		t.Errorf("nil tracker anomalyReport() = %q, want empty", report)
+	}
+	if snap := tracker.snapshot(now); snap != nil {
+		t.Errorf("nil tracker snapshot() = %v, want nil", snap)
+	}
+}
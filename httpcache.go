@@ -0,0 +1,226 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// ================== HTTP CACHE ==================
+// Most career pages don't change between hourly runs. The cache remembers each
+// page's ETag, Last-Modified and body hash along with the jobs it parsed to, so
+// the next run revalidates with If-None-Match/If-Modified-Since and, on a 304
+// or an identical body, reuses those jobs without parsing the page again.
+
+// HTTPCacheConfig is the `http.cache` block in config.yaml
+type HTTPCacheConfig struct {
+	Enabled  bool   `yaml:"enabled"`
+	Path     string `yaml:"path"`      // JSON file; keep it where CI caches it
+	TTLHours int    `yaml:"ttl_hours"` // Re-download and re-parse pages cached longer than this
+}
+
+const (
+	defaultHTTPCachePath = ".cache/http.json"
+	defaultHTTPCacheTTL  = 24
+)
+
+// httpCacheEntry is what the last full download of a URL left behind
+type httpCacheEntry struct {
+	ETag         string `json:"etag,omitempty"`
+	LastModified string `json:"last_modified,omitempty"`
+	BodyHash     string `json:"body_hash"`
+	Variant      string `json:"variant,omitempty"` // How the body was parsed (e.g. the selector)
+	Jobs         []Job  `json:"jobs"`
+	StoredAt     int64  `json:"stored_at"`
+}
+
+// HTTPCache is the on-disk validator and result cache. A nil *HTTPCache is a
+// disabled cache: every fetch downloads and nothing is stored.
+type HTTPCache struct {
+	path    string
+	ttl     time.Duration
+	mu      sync.Mutex
+	entries map[string]httpCacheEntry
+	hits    int
+}
+
+// httpCache is opened for normal runs only; the self-test and detect always
+// download so they report what the site returns now
+var httpCache *HTTPCache
+
+// openHTTPCache loads the cache file, or returns nil when caching is off
+func openHTTPCache(c HTTPCacheConfig) *HTTPCache {
+	if !c.Enabled {
+		return nil
+	}
+	if c.Path == "" {
+		c.Path = defaultHTTPCachePath
+	}
+	if c.TTLHours <= 0 {
+		c.TTLHours = defaultHTTPCacheTTL
+	}
+
+	cache := &HTTPCache{
+		path:    c.Path,
+		ttl:     time.Duration(c.TTLHours) * time.Hour,
+		entries: map[string]httpCacheEntry{},
+	}
+	data, err := os.ReadFile(c.Path)
+	if err != nil {
+		return cache
+	}
+	if err := json.Unmarshal(data, &cache.entries); err != nil {
+		fmt.Printf("Warning: ignoring unreadable HTTP cache %s: %v\n", c.Path, err)
+		cache.entries = map[string]httpCacheEntry{}
+	}
+	return cache
+}
+
+// lookup returns the entry for url if it is younger than the TTL
+func (c *HTTPCache) lookup(url string) (httpCacheEntry, bool) {
+	if c == nil {
+		return httpCacheEntry{}, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[url]
+	if !ok || time.Since(time.Unix(entry.StoredAt, 0)) > c.ttl {
+		return httpCacheEntry{}, false
+	}
+	return entry, true
+}
+
+func (c *HTTPCache) store(url string, entry httpCacheEntry) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[url] = entry
+}
+
+// revalidated refreshes the validators of an unchanged entry. StoredAt is
+// kept, so the TTL still forces a fresh parse now and then.
+func (c *HTTPCache) revalidated(url string, entry httpCacheEntry) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[url] = entry
+	c.hits++
+}
+
+// save writes the cache without expired entries
+func (c *HTTPCache) save() error {
+	if c == nil {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for url, entry := range c.entries {
+		if time.Since(time.Unix(entry.StoredAt, 0)) > c.ttl {
+			delete(c.entries, url)
+		}
+	}
+	if c.hits > 0 {
+		fmt.Printf("HTTP cache: %d unchanged pages reused\n", c.hits)
+	}
+
+	data, err := json.Marshal(c.entries)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(c.path, data, 0644)
+}
+
+// cachedPage is the result of fetchCachedPage
+type cachedPage struct {
+	Body      []byte // Empty when Unchanged
+	Unchanged bool   // 304 or the same body as last time; Jobs is what it parsed to
+	Jobs      []Job
+
+	url   string
+	entry httpCacheEntry
+}
+
+// fetchCachedPage downloads url unless the cache shows it hasn't changed.
+// variant names how the caller parses the body, so editing a company's
+// selector invalidates its entry. After parsing a changed page, call remember.
+func fetchCachedPage(ctx context.Context, url, variant string, profile headerProfile) (*cachedPage, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	cached, ok := httpCache.lookup(url)
+	if ok && cached.Variant != variant {
+		ok = false
+	}
+	if ok {
+		if cached.ETag != "" {
+			req.Header.Set("If-None-Match", cached.ETag)
+		}
+		if cached.LastModified != "" {
+			req.Header.Set("If-Modified-Since", cached.LastModified)
+		}
+	}
+
+	resp, err := fetcher.Do(req, profile)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotModified && ok {
+		httpCache.revalidated(url, cached)
+		return &cachedPage{Unchanged: true, Jobs: cached.Jobs}, nil
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("status %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBytes))
+	if err != nil {
+		return nil, err
+	}
+
+	sum := sha256.Sum256(body)
+	entry := httpCacheEntry{
+		ETag:         resp.Header.Get("ETag"),
+		LastModified: resp.Header.Get("Last-Modified"),
+		BodyHash:     hex.EncodeToString(sum[:]),
+		Variant:      variant,
+		StoredAt:     time.Now().Unix(),
+	}
+
+	// Many servers send no validators, or change them every response
+	if ok && entry.BodyHash == cached.BodyHash {
+		entry.Jobs, entry.StoredAt = cached.Jobs, cached.StoredAt
+		httpCache.revalidated(url, entry)
+		return &cachedPage{Unchanged: true, Jobs: cached.Jobs}, nil
+	}
+
+	return &cachedPage{Body: body, url: url, entry: entry}, nil
+}
+
+// remember stores the jobs a changed page parsed to, for later runs to reuse
+func (p *cachedPage) remember(jobs []Job) {
+	if p.Unchanged {
+		return
+	}
+	p.entry.Jobs = jobs
+	httpCache.store(p.url, p.entry)
+}
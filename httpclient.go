@@ -0,0 +1,377 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/http/cookiejar"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// ================== HTTP FETCHER ==================
+// Every source sends its requests through one fetcher, which shares a
+// connection pool, spaces requests to each host with a token bucket, retries
+// 429s, 5xx and network errors with jittered exponential backoff (waiting as
+// long as Retry-After asks), and sets one of a few consistent header profiles.
+
+// HTTPConfig is the `http:` block in config.yaml
+type HTTPConfig struct {
+	TimeoutSeconds       int                `yaml:"timeout_seconds"`         // Whole request, including the body
+	MaxRetries           int                `yaml:"max_retries"`             // Retries after the first attempt
+	BackoffSeconds       float64            `yaml:"backoff_seconds"`         // First retry delay; doubles each retry
+	MaxBackoffSeconds    float64            `yaml:"max_backoff_seconds"`     // Cap on one retry delay
+	MaxRetryAfterSeconds int                `yaml:"max_retry_after_seconds"` // Longer Retry-After values fail instead of waiting
+	RequestsPerSecond    float64            `yaml:"requests_per_second"`     // Per host
+	Burst                int                `yaml:"burst"`                   // Requests a host may get back to back
+	HostLimits           map[string]float64 `yaml:"host_limits"`             // Requests per second for a host and its subdomains
+	MaxConnsPerHost      int                `yaml:"max_conns_per_host"`
+	Cache                HTTPCacheConfig    `yaml:"cache"` // Conditional GETs for career pages
+}
+
+const (
+	defaultHTTPTimeout       = 20
+	defaultHTTPMaxRetries    = 3
+	defaultHTTPBackoff       = 1.0
+	defaultHTTPMaxBackoff    = 30.0
+	defaultHTTPMaxRetryAfter = 120
+	defaultHTTPRate          = 2.0
+	defaultHTTPBurst         = 4
+	defaultHTTPMaxConns      = 8
+	maxResponseBytes         = 10 << 20
+)
+
+// headerProfile selects the browser-like headers a request is sent with
+type headerProfile int
+
+const (
+	profileBrowser headerProfile = iota // Navigating to an HTML page
+	profileJSON                         // A page's own XHR to its JSON API
+	profileFeed                         // RSS/Atom reader
+)
+
+const browserUserAgent = "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/121.0.0.0 Safari/537.36"
+
+// profileHeaders are set unless the request already has that header
+var profileHeaders = map[headerProfile]map[string]string{
+	profileBrowser: {
+		"User-Agent":                browserUserAgent,
+		"Accept":                    "text/html,application/xhtml+xml,application/xml;q=0.9,image/avif,image/webp,*/*;q=0.8",
+		"Accept-Language":           "en-US,en;q=0.9",
+		"Sec-Ch-Ua":                 `"Not A(Brand";v="99", "Google Chrome";v="121", "Chromium";v="121"`,
+		"Sec-Ch-Ua-Mobile":          "?0",
+		"Sec-Ch-Ua-Platform":        `"macOS"`,
+		"Sec-Fetch-Dest":            "document",
+		"Sec-Fetch-Mode":            "navigate",
+		"Sec-Fetch-Site":            "none",
+		"Sec-Fetch-User":            "?1",
+		"Upgrade-Insecure-Requests": "1",
+	},
+	profileJSON: {
+		"User-Agent":      browserUserAgent,
+		"Accept":          "application/json, text/plain, */*",
+		"Accept-Language": "en-US,en;q=0.9",
+		"Sec-Fetch-Dest":  "empty",
+		"Sec-Fetch-Mode":  "cors",
+		"Sec-Fetch-Site":  "same-origin",
+	},
+	profileFeed: {
+		"User-Agent": browserUserAgent,
+		"Accept":     "application/rss+xml, application/atom+xml, application/xml;q=0.9, text/xml;q=0.8, */*;q=0.5",
+	},
+}
+
+// Fetcher is the shared HTTP client. Use the package-level fetcher.
+type Fetcher struct {
+	cfg    HTTPConfig
+	client *http.Client
+
+	mu      sync.Mutex
+	buckets map[string]*tokenBucket
+}
+
+// fetcher starts with defaults so it works before config.yaml is read;
+// main replaces it with one built from the `http:` block
+var fetcher = newFetcher(HTTPConfig{})
+
+func newFetcher(c HTTPConfig) *Fetcher {
+	if c.TimeoutSeconds <= 0 {
+		c.TimeoutSeconds = defaultHTTPTimeout
+	}
+	if c.MaxRetries < 0 {
+		c.MaxRetries = 0
+	} else if c.MaxRetries == 0 {
+		c.MaxRetries = defaultHTTPMaxRetries
+	}
+	if c.BackoffSeconds <= 0 {
+		c.BackoffSeconds = defaultHTTPBackoff
+	}
+	if c.MaxBackoffSeconds <= 0 {
+		c.MaxBackoffSeconds = defaultHTTPMaxBackoff
+	}
+	if c.MaxRetryAfterSeconds <= 0 {
+		c.MaxRetryAfterSeconds = defaultHTTPMaxRetryAfter
+	}
+	if c.RequestsPerSecond <= 0 {
+		c.RequestsPerSecond = defaultHTTPRate
+	}
+	if c.Burst <= 0 {
+		c.Burst = defaultHTTPBurst
+	}
+	if c.MaxConnsPerHost <= 0 {
+		c.MaxConnsPerHost = defaultHTTPMaxConns
+	}
+
+	transport := &http.Transport{
+		Proxy:                 http.ProxyFromEnvironment,
+		DialContext:           (&net.Dialer{Timeout: 10 * time.Second, KeepAlive: 30 * time.Second}).DialContext,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          100,
+		MaxIdleConnsPerHost:   c.MaxConnsPerHost,
+		MaxConnsPerHost:       c.MaxConnsPerHost,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: time.Second,
+	}
+
+	return &Fetcher{
+		cfg:     c,
+		client:  &http.Client{Transport: transport, Timeout: time.Duration(c.TimeoutSeconds) * time.Second},
+		buckets: map[string]*tokenBucket{},
+	}
+}
+
+// Do sends req with the profile's headers, waiting for the host's rate limit
+// and retrying transient failures. Any response that isn't retried, or the
+// last one, is returned as is; callers check the status. Waits and retries
+// stop when the request's context is done.
+func (f *Fetcher) Do(req *http.Request, profile headerProfile) (*http.Response, error) {
+	return f.do(f.client, req, profile)
+}
+
+// Session returns a client that keeps cookies between requests, for sites
+// that hand out a session or CSRF token on the first page
+func (f *Fetcher) Session() *FetchSession {
+	jar, _ := cookiejar.New(nil)
+	return &FetchSession{
+		fetcher: f,
+		client:  &http.Client{Transport: f.client.Transport, Timeout: f.client.Timeout, Jar: jar},
+	}
+}
+
+// FetchSession is a cookie-keeping view of the fetcher
+type FetchSession struct {
+	fetcher *Fetcher
+	client  *http.Client
+}
+
+func (s *FetchSession) Do(req *http.Request, profile headerProfile) (*http.Response, error) {
+	return s.fetcher.do(s.client, req, profile)
+}
+
+func (f *Fetcher) do(client *http.Client, req *http.Request, profile headerProfile) (*http.Response, error) {
+	for key, value := range profileHeaders[profile] {
+		if req.Header.Get(key) == "" {
+			req.Header.Set(key, value)
+		}
+	}
+
+	bucket := f.bucket(req.URL.Hostname())
+
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.Body != nil {
+			if req.GetBody == nil {
+				return nil, fmt.Errorf("cannot retry %s %s: body can't be replayed", req.Method, req.URL)
+			}
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+
+		if err := bucket.wait(req.Context()); err != nil {
+			return nil, err
+		}
+		resp, err := client.Do(req)
+		if err == nil {
+			traceStatus(req.Context(), resp.StatusCode)
+		}
+
+		retryable, wait := f.shouldRetry(resp, err, attempt)
+		if !retryable || attempt >= f.cfg.MaxRetries || req.Context().Err() != nil {
+			return resp, err
+		}
+		if resp != nil {
+			io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10)) // Lets the connection be reused
+			resp.Body.Close()
+		}
+		if err := sleepContext(req.Context(), wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// shouldRetry decides whether a result is transient and how long to wait
+// before the next attempt
+func (f *Fetcher) shouldRetry(resp *http.Response, err error, attempt int) (bool, time.Duration) {
+	if err != nil {
+		// Every client error is a *url.Error, which is a net.Error, so only
+		// timeouts and dropped connections count. DNS, TLS, bad URLs and
+		// redirect policy fail the same way on every attempt.
+		var netErr net.Error
+		switch {
+		case errors.As(err, &netErr) && netErr.Timeout(),
+			errors.Is(err, syscall.ECONNRESET), errors.Is(err, syscall.ECONNREFUSED),
+			errors.Is(err, io.ErrUnexpectedEOF), errors.Is(err, io.EOF):
+			return true, f.backoff(attempt)
+		}
+		return false, 0
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests, resp.StatusCode == http.StatusServiceUnavailable:
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+			if wait > time.Duration(f.cfg.MaxRetryAfterSeconds)*time.Second {
+				return false, 0 // Blocked for longer than a run is worth waiting
+			}
+			return true, wait
+		}
+		return true, f.backoff(attempt)
+	case resp.StatusCode >= 500 && resp.StatusCode != http.StatusNotImplemented:
+		return true, f.backoff(attempt)
+	}
+	return false, 0
+}
+
+// backoff is base * 2^attempt, capped, with full jitter in its upper half
+func (f *Fetcher) backoff(attempt int) time.Duration {
+	d := math.Min(f.cfg.BackoffSeconds*math.Pow(2, float64(attempt)), f.cfg.MaxBackoffSeconds)
+	d = d/2 + rand.Float64()*d/2
+	return time.Duration(d * float64(time.Second))
+}
+
+// parseRetryAfter reads delta-seconds or an HTTP date
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			seconds = 0
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		if wait := at.Sub(now); wait > 0 {
+			return wait, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// bucket returns the rate limiter for host, creating it on first use.
+// www.example.com and example.com share one.
+func (f *Fetcher) bucket(host string) *tokenBucket {
+	host = strings.TrimPrefix(strings.ToLower(host), "www.")
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if b, ok := f.buckets[host]; ok {
+		return b
+	}
+
+	// The most specific entry wins, so boards.greenhouse.io can override greenhouse.io
+	rate, matched := f.cfg.RequestsPerSecond, ""
+	for suffix, limit := range f.cfg.HostLimits {
+		suffix = strings.ToLower(suffix)
+		if limit > 0 && len(suffix) > len(matched) && (host == suffix || strings.HasSuffix(host, "."+suffix)) {
+			rate, matched = limit, suffix
+		}
+	}
+
+	b := newTokenBucket(rate, f.cfg.Burst)
+	f.buckets[host] = b
+	return b
+}
+
+// tokenBucket allows burst requests at once and then rate per second.
+// Tokens may go negative: each waiter reserves its slot and sleeps until then.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	return &tokenBucket{rate: rate, burst: float64(burst), tokens: float64(burst), last: time.Now()}
+}
+
+// wait blocks until the caller's slot, or returns early if ctx is done
+func (b *tokenBucket) wait(ctx context.Context) error {
+	b.mu.Lock()
+	now := time.Now()
+	b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+	b.tokens--
+	var delay time.Duration
+	if b.tokens < 0 {
+		delay = time.Duration(-b.tokens / b.rate * float64(time.Second))
+	}
+	b.mu.Unlock()
+
+	return sleepContext(ctx, delay)
+}
+
+// ================== HELPERS ==================
+
+// sleepContext sleeps for d, returning ctx's error if it is done first.
+// Use it for delays between pages so a deadline cuts them short.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// httpGet fetches a URL and returns the body of a 200 response
+func httpGet(ctx context.Context, url string, profile headerProfile) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return readResponse(fetcher.Do(req, profile))
+}
+
+// readResponse returns the body of a 200 response and an error otherwise
+func readResponse(resp *http.Response, err error) ([]byte, error) {
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("status %d", resp.StatusCode)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, maxResponseBytes))
+}
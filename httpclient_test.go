@@ -0,0 +1,153 @@
+package main
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestShouldRetry(t *testing.T) {
+	f := newFetcher(HTTPConfig{MaxRetryAfterSeconds: 60})
+	clientErr := func(err error) error {
+		return &url.Error{Op: "Get", URL: "https://example.com/jobs", Err: err}
+	}
+	response := func(status int, retryAfter string) *http.Response {
+		resp := &http.Response{StatusCode: status, Header: http.Header{}}
+		if retryAfter != "" {
+			resp.Header.Set("Retry-After", retryAfter)
+		}
+		return resp
+	}
+
+	tests := []struct {
+		name string
+		resp *http.Response
+		err  error
+		want bool
+	}{
+		{"dial timeout", nil, clientErr(&net.OpError{Op: "dial", Err: &net.DNSError{IsTimeout: true}}), true},
+		{"connection reset", nil, clientErr(&net.OpError{Op: "read", Err: os.NewSyscallError("read", syscall.ECONNRESET)}), true},
+		{"connection refused", nil, clientErr(&net.OpError{Op: "dial", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)}), true},
+		{"unexpected EOF", nil, clientErr(io.ErrUnexpectedEOF), true},
+		{"no such host", nil, clientErr(&net.OpError{Op: "dial", Err: &net.DNSError{Err: "no such host", Name: "example.invalid", IsNotFound: true}}), false},
+		{"bad certificate", nil, clientErr(x509.UnknownAuthorityError{}), false},
+		{"unsupported scheme", nil, clientErr(errors.New(`unsupported protocol scheme "ftp"`)), false},
+		{"redirect policy", nil, clientErr(errors.New("stopped after 10 redirects")), false},
+		{"200", response(200, ""), nil, false},
+		{"404", response(404, ""), nil, false},
+		{"501", response(501, ""), nil, false},
+		{"502", response(502, ""), nil, true},
+		{"429 without Retry-After", response(429, ""), nil, true},
+		{"429 with short Retry-After", response(429, "5"), nil, true},
+		{"429 with long Retry-After", response(429, "3600"), nil, false},
+		{"503 with Retry-After", response(503, "1"), nil, true},
+	}
+	for _, tt := range tests {
+		if got, _ := f.shouldRetry(tt.resp, tt.err, 0); got != tt.want {
+			t.Errorf("%s: shouldRetry = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	if _, wait := f.shouldRetry(response(429, "5"), nil, 0); wait != 5*time.Second {
+		t.Errorf("Retry-After 5: waited %v, want 5s", wait)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, 6, 2, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		value string
+		wait  time.Duration
+		ok    bool
+	}{
+		{"", 0, false},
+		{"120", 2 * time.Minute, true},
+		{" 7 ", 7 * time.Second, true},
+		{"-3", 0, true},
+		{"Mon, 02 Jun 2025 10:00:30 GMT", 30 * time.Second, true},
+		{"Mon, 02 Jun 2025 09:59:00 GMT", 0, true},
+		{"soon", 0, false},
+	}
+	for _, tt := range tests {
+		wait, ok := parseRetryAfter(tt.value, now)
+		if wait != tt.wait || ok != tt.ok {
+			t.Errorf("parseRetryAfter(%q) = (%v, %v), want (%v, %v)", tt.value, wait, ok, tt.wait, tt.ok)
+		}
+	}
+}
+
+func TestFetcherBucketHostLimits(t *testing.T) {
+	cfg := HTTPConfig{
+		RequestsPerSecond: 2,
+		Burst:             1,
+		HostLimits:        map[string]float64{"greenhouse.io": 1, "boards.greenhouse.io": 4, "Lever.co": 0.5, "ashbyhq.com": 0},
+	}
+	tests := []struct {
+		host string
+		rate float64
+	}{
+		{"boards.greenhouse.io", 4},
+		{"job-boards.eu.boards.greenhouse.io", 4},
+		{"api.greenhouse.io", 1},
+		{"www.greenhouse.io", 1},
+		{"api.lever.co", 0.5},
+		{"notlever.co", 2},
+		{"jobs.ashbyhq.com", 2},
+	}
+
+	// Map order varies, so one pass could pick the right suffix by luck
+	for i := 0; i < 20; i++ {
+		f := newFetcher(cfg)
+		for _, tt := range tests {
+			if got := f.bucket(tt.host).rate; got != tt.rate {
+				t.Fatalf("bucket(%q).rate = %v, want %v", tt.host, got, tt.rate)
+			}
+		}
+	}
+}
+
+func TestStatusTraceCoversSessions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/missing" {
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	f := newFetcher(HTTPConfig{})
+	session := f.Session()
+
+	for _, do := range []func(*http.Request, headerProfile) (*http.Response, error){f.Do, session.Do} {
+		ctx, trace := withStatusTrace(context.Background())
+		for _, path := range []string{"/", "/missing"} {
+			req, _ := http.NewRequestWithContext(ctx, "GET", server.URL+path, nil)
+			resp, err := do(req, profileJSON)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+		}
+		if got := trace.last(); got != http.StatusNotFound {
+			t.Errorf("trace.last() = %d, want %d", got, http.StatusNotFound)
+		}
+	}
+
+	// Requests without the trace in their context don't touch it
+	_, trace := withStatusTrace(context.Background())
+	req, _ := http.NewRequest("GET", server.URL, nil)
+	if resp, err := f.Do(req, profileJSON); err == nil {
+		resp.Body.Close()
+	}
+	if got := trace.last(); got != 0 {
+		t.Errorf("untraced request set trace.last() = %d, want 0", got)
+	}
+}
@@ -1,8 +1,9 @@
 package main
 
 import (
+	"bytes"
+	"context"
 	"fmt"
-	"io"
 	"net/http"
 	"regexp"
 	"strings"
@@ -10,22 +11,42 @@ import (
 	"github.com/PuerkitoBio/goquery"
 )
 
-// fetchIndeedJobs fetches jobs from multiple Indeed RSS feeds
-// Falls back to HTML scraping if RSS fails
-func fetchIndeedJobs(rssURLs []string) ([]Job, error) {
+func init() {
+	// Feed list is read at fetch time so it reflects the loaded config
+	registerSource(newSource("Indeed", "indeed", func(ctx context.Context) ([]Job, error) {
+		return fetchIndeedJobs(ctx, cfg.IndeedRSS)
+	}))
+}
+
+// fetchIndeedJobs reads each configured Indeed URL. RSS feeds (/rss?q=...)
+// are parsed as feeds; search pages (/jobs?q=...) are scraped, and a feed
+// that fails falls back to scraping its search page.
+func fetchIndeedJobs(ctx context.Context, rssURLs []string) ([]Job, error) {
 	if len(rssURLs) == 0 {
-		rssURLs = []string{"https://www.indeed.com/jobs?q=backend+go&l=India"}
+		rssURLs = []string{"https://www.indeed.com/rss?q=backend+go&l=India"}
 	}
 
 	var allJobs []Job
 
 	for _, url := range rssURLs {
-		// Convert RSS URLs to regular search URLs
-		searchURL := strings.Replace(url, "/rss?", "/jobs?", 1)
+		if ctx.Err() != nil {
+			break
+		}
+		if strings.Contains(url, "/rss") {
+			items, err := fetchFeed(ctx, url)
+			if err == nil {
+				for _, item := range items {
+					allJobs = append(allJobs, indeedFeedJob(item))
+				}
+				continue
+			}
+			fmt.Printf("  Warning: Indeed feed %s failed (%v), trying the search page\n", url, err)
+			url = strings.Replace(url, "/rss?", "/jobs?", 1)
+		}
 
-		jobs, err := scrapeIndeedPage(searchURL)
+		jobs, err := scrapeIndeedPage(ctx, url)
 		if err != nil {
-			fmt.Printf("  Warning: Could not scrape %s: %v\n", searchURL, err)
+			fmt.Printf("  Warning: Could not scrape %s: %v\n", url, err)
 			continue
 		}
 		allJobs = append(allJobs, jobs...)
@@ -34,32 +55,60 @@ func fetchIndeedJobs(rssURLs []string) ([]Job, error) {
 	return allJobs, nil
 }
 
-func scrapeIndeedPage(url string) ([]Job, error) {
-	req, _ := http.NewRequest("GET", url, nil)
-	// Use a modern User-Agent to reduce Cloudflare blocking
-	req.Header.Set("User-Agent", "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/122.0.0.0 Safari/537.36")
-	req.Header.Set("Accept", "text/html,application/xhtml+xml,application/xml;q=0.9,image/avif,image/webp,*/*;q=0.8")
-	req.Header.Set("Accept-Language", "en-US,en;q=0.5")
+// indeedFeedJob maps an Indeed RSS item. Titles read
+// "Software Engineer - Acme Corp - Bengaluru, Karnataka"; <source> is the employer.
+func indeedFeedJob(item feedItem) Job {
+	title, company, location := item.Title, item.Author, ""
+	if parts := strings.Split(item.Title, " - "); len(parts) >= 3 {
+		title = strings.Join(parts[:len(parts)-2], " - ")
+		location = parts[len(parts)-1]
+		if company == "" {
+			company = parts[len(parts)-2]
+		}
+	}
+
+	var jobID string
+	if matches := indeedJobKeyRegex.FindStringSubmatch(item.Link); len(matches) > 1 {
+		jobID = matches[1]
+	}
+
+	description := htmlToText(item.Description)
+	expMin, expMax := parseExperienceRange(description)
+
+	return Job{
+		ID:            makeJobID("indeed", jobID, item.Link),
+		Title:         title,
+		Link:          item.Link,
+		Source:        "Indeed",
+		Company:       company,
+		Location:      location,
+		WorkMode:      detectWorkMode(location + " " + title),
+		ExperienceMin: expMin,
+		ExperienceMax: expMax,
+		Description:   description,
+		Date:          item.Published,
+	}
+}
+
+var indeedJobKeyRegex = regexp.MustCompile(`jk=([a-f0-9]+)`)
+
+func scrapeIndeedPage(ctx context.Context, url string) ([]Job, error) {
+	req, _ := http.NewRequestWithContext(ctx, "GET", url, nil)
+	// Arriving from a search engine reduces Cloudflare blocking
 	req.Header.Set("Referer", "https://www.google.com/")
-	req.Header.Set("Upgrade-Insecure-Requests", "1")
-	req.Header.Set("Sec-Fetch-Dest", "document")
-	req.Header.Set("Sec-Fetch-Mode", "navigate")
 	req.Header.Set("Sec-Fetch-Site", "cross-site")
 
-	resp, err := http.DefaultClient.Do(req)
+	body, err := readResponse(fetcher.Do(req, profileBrowser))
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
 
-	body, _ := io.ReadAll(resp.Body)
-	doc, err := goquery.NewDocumentFromReader(strings.NewReader(string(body)))
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
 	if err != nil {
 		return nil, err
 	}
 
 	var jobs []Job
-	jobIDRegex := regexp.MustCompile(`jk=([a-f0-9]+)`)
 
 	// Indeed job cards
 	doc.Find(".job_seen_beacon, .jobsearch-ResultsList > li, .resultContent").Each(func(i int, s *goquery.Selection) {
@@ -81,24 +130,28 @@ func scrapeIndeedPage(url string) ([]Job, error) {
 			return
 		}
 
+		// Make absolute URL
+		if !strings.HasPrefix(link, "http") {
+			link = "https://www.indeed.com" + link
+		}
+
 		// Extract job ID
 		var jobID string
-		if matches := jobIDRegex.FindStringSubmatch(link); len(matches) > 1 {
+		if matches := indeedJobKeyRegex.FindStringSubmatch(link); len(matches) > 1 {
 			jobID = matches[1]
-		} else {
-			jobID = fmt.Sprintf("%d", i)
 		}
 
-		// Make absolute URL
-		if !strings.HasPrefix(link, "http") {
-			link = "https://www.indeed.com" + link
-		}
+		company := strings.TrimSpace(s.Find("[data-testid='company-name'], .companyName").First().Text())
+		location := strings.TrimSpace(s.Find("[data-testid='text-location'], .companyLocation").First().Text())
 
 		jobs = append(jobs, Job{
-			ID:     "indeed-" + jobID,
-			Title:  title,
-			Link:   link,
-			Source: "Indeed",
+			ID:       makeJobID("indeed", jobID, link),
+			Title:    title,
+			Link:     link,
+			Source:   "Indeed",
+			Company:  company,
+			Location: location,
+			WorkMode: detectWorkMode(location),
 		})
 	})
 
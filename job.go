@@ -0,0 +1,119 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// WorkMode says where the work happens. Empty means the source didn't say.
+type WorkMode string
+
+const (
+	WorkModeRemote WorkMode = "remote"
+	WorkModeHybrid WorkMode = "hybrid"
+	WorkModeOnsite WorkMode = "onsite"
+)
+
+type Job struct {
+	ID     string `json:"id"`
+	Title  string `json:"title"` // Role only, e.g. "Backend Engineer" - company and location have their own fields
+	Link   string `json:"link"`
+	Source string `json:"source,omitempty"`
+
+	Company        string   `json:"company,omitempty"`
+	Location       string   `json:"location,omitempty"`
+	WorkMode       WorkMode `json:"work_mode,omitempty"`
+	ExperienceMin  int      `json:"experience_min,omitempty"`  // Years; both 0 when unknown
+	ExperienceMax  int      `json:"experience_max,omitempty"`  // Years; 0 for open-ended ("2+ years")
+	EmploymentType string   `json:"employment_type,omitempty"` // e.g. "Full-time", "Internship"
+	Description    string   `json:"description,omitempty"`     // Plain text
+	Salary         string   `json:"salary,omitempty"`
+	Tags           []string `json:"tags,omitempty"`
+
+	Date time.Time `json:"date,omitempty"` // When the job was posted, for date filtering
+}
+
+// displayTitle renders "Title @ Company (Location)" for notifications and logs
+func (j Job) displayTitle() string {
+	title := j.Title
+	if j.Company != "" {
+		title = fmt.Sprintf("%s @ %s", title, j.Company)
+	}
+
+	where := j.Location
+	if j.WorkMode == WorkModeRemote && !strings.Contains(strings.ToLower(where), "remote") {
+		if where == "" {
+			where = "Remote"
+		} else {
+			where += ", Remote"
+		}
+	}
+	if where != "" {
+		title = fmt.Sprintf("%s (%s)", title, where)
+	}
+	return title
+}
+
+// hasExperience reports whether the source gave an explicit experience range
+func (j Job) hasExperience() bool {
+	return j.ExperienceMin > 0 || j.ExperienceMax > 0
+}
+
+// detectWorkMode guesses the work mode from free text such as a location string.
+// Returns "" when nothing in the text says either way.
+func detectWorkMode(text string) WorkMode {
+	lower := strings.ToLower(text)
+	switch {
+	case strings.Contains(lower, "hybrid"):
+		return WorkModeHybrid
+	case strings.Contains(lower, "remote"), strings.Contains(lower, "work from home"), strings.Contains(lower, "wfh"):
+		return WorkModeRemote
+	case strings.Contains(lower, "on-site"), strings.Contains(lower, "onsite"), strings.Contains(lower, "in office"):
+		return WorkModeOnsite
+	}
+	return ""
+}
+
+// The unit is required: descriptions are full of "1000+ employees",
+// "9 to 6 shift" and "2024-2026" that aren't experience
+var experienceRangeRegex = regexp.MustCompile(`(\d+)\s*(?:-|to|–)\s*(\d+)\s*(?:years?|yrs?)\b|(\d+)\s*\+\s*(?:years?|yrs?)\b`)
+
+// parseExperienceRange reads strings like "0-2 years", "1 to 3 yrs" or "2+ years".
+// Returns (0, 0) when no range is found.
+func parseExperienceRange(text string) (int, int) {
+	m := experienceRangeRegex.FindStringSubmatch(strings.ToLower(text))
+	if m == nil {
+		return 0, 0
+	}
+
+	var min, max int
+	if m[1] != "" {
+		fmt.Sscanf(m[1], "%d", &min)
+		fmt.Sscanf(m[2], "%d", &max)
+	} else {
+		fmt.Sscanf(m[3], "%d", &min)
+	}
+	return min, max
+}
+
+var whitespaceRegex = regexp.MustCompile(`\s+`)
+
+// htmlToText strips markup from a description and collapses whitespace
+func htmlToText(html string) string {
+	if !strings.Contains(html, "<") {
+		return strings.TrimSpace(whitespaceRegex.ReplaceAllString(html, " "))
+	}
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+	if err != nil {
+		return strings.TrimSpace(html)
+	}
+	// Keep paragraph breaks readable once tags are gone
+	doc.Find("br, p, li, div, h1, h2, h3, h4").Each(func(i int, s *goquery.Selection) {
+		s.AppendHtml(" ")
+	})
+	return strings.TrimSpace(whitespaceRegex.ReplaceAllString(doc.Text(), " "))
+}
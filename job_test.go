@@ -0,0 +1,47 @@
+package main
+
+import "testing"
+
+func TestParseExperienceRange(t *testing.T) {
+	tests := []struct {
+		text     string
+		min, max int
+	}{
+		{"0-2 years", 0, 2},
+		{"1 to 3 yrs", 1, 3},
+		{"2+ years of experience", 2, 0},
+		{"Experience: 1 – 4 Years", 1, 4},
+		{"0-1 yr", 0, 1},
+		{"Fresher", 0, 0},
+		{"", 0, 0},
+		{"Join our team of 1000+ employees", 0, 0},
+		{"General 9 to 6 shift, Monday to Friday", 0, 0},
+		{"CTC ₹30000-50000 per month", 0, 0},
+		{"Graduating batch 2024-2026", 0, 0},
+		{"Batch of 2025, 5 to 6 LPA", 0, 0},
+		{"We've grown 200+ yearly customers", 0, 0},
+		{"1000+ employees; 0-2 years experience", 0, 2},
+	}
+	for _, tt := range tests {
+		min, max := parseExperienceRange(tt.text)
+		if min != tt.min || max != tt.max {
+			t.Errorf("parseExperienceRange(%q) = (%d, %d), want (%d, %d)", tt.text, min, max, tt.min, tt.max)
+		}
+	}
+}
+
+func TestFeedJobExperience(t *testing.T) {
+	item := feedItem{
+		Title:       "Junior Backend Engineer - Acme - Bengaluru",
+		Link:        "https://in.indeed.com/viewjob?jk=abc123",
+		Description: "<p>Acme has 1000+ employees across 12 cities.</p><p>9 to 6 shift.</p>",
+	}
+	if job := indeedFeedJob(item); job.hasExperience() {
+		t.Errorf("indeedFeedJob experience = (%d, %d), want none", job.ExperienceMin, job.ExperienceMax)
+	}
+
+	item.Description = "<p>Requires 1-3 years of Go.</p>"
+	if job := indeedFeedJob(item); job.ExperienceMin != 1 || job.ExperienceMax != 3 {
+		t.Errorf("indeedFeedJob experience = (%d, %d), want (1, 3)", job.ExperienceMin, job.ExperienceMax)
+	}
+}
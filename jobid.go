@@ -0,0 +1,229 @@
+package main
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// ================== JOB IDS ==================
+// Job.ID is the dedup key across runs, so it must name the same job every run
+// and never two jobs. Every source builds it with makeJobID: the site's own ID
+// when it has one, else a hash of the canonical link. Never a loop index.
+
+// makeJobID returns "<prefix>-<nativeID>", or "<prefix>-<hash of the
+// canonical link>" when the source exposes no ID of its own. prefix is the
+// source ("linkedin") or the company slug for career pages.
+func makeJobID(prefix, nativeID, link string) string {
+	if id := cleanNativeID(nativeID); id != "" {
+		return prefix + "-" + id
+	}
+	return prefix + "-" + generateStableHash(canonicalJobURL(link))
+}
+
+// cleanNativeID drops the characters that once leaked into IDs from URLs
+func cleanNativeID(id string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '/', '?', '#', ' ', '\t', '\n':
+			return -1
+		}
+		return r
+	}, strings.TrimSpace(id))
+}
+
+// trackingParams are query parameters that differ between listings of the
+// same job (search position, referrer, campaign) and so are left out of the hash
+var trackingParams = map[string]bool{
+	"ref": true, "refid": true, "trackingid": true, "trk": true, "position": true,
+	"pagenum": true, "src": true, "source": true, "from": true, "fbclid": true,
+	"gclid": true, "gh_src": true, "lever-source": true, "lever-origin": true,
+	"tk": true, "vjs": true, "sid": true,
+}
+
+// canonicalJobURL normalises a link so the same job always hashes alike:
+// https, lower-case host without www., no fragment, no tracking parameters,
+// sorted query and no trailing slash
+func canonicalJobURL(link string) string {
+	u, err := url.Parse(strings.TrimSpace(link))
+	if err != nil || u.Host == "" {
+		return strings.TrimSpace(link)
+	}
+
+	u.Scheme = "https"
+	u.Host = strings.TrimPrefix(strings.ToLower(u.Host), "www.")
+	u.Fragment = ""
+	u.User = nil
+	u.Path = strings.TrimRight(u.Path, "/")
+
+	query := u.Query()
+	for key := range query {
+		lower := strings.ToLower(key)
+		if trackingParams[lower] || strings.HasPrefix(lower, "utm_") {
+			query.Del(key)
+		}
+	}
+	u.RawQuery = query.Encode() // Encode sorts by key
+
+	return u.String()
+}
+
+// ================== MIGRATION ==================
+// Records written before makeJobID have IDs built from loop indexes
+// ("indeed-3"), whole URLs ("razorpay-https://...") or hashes of the raw link.
+// Records with a link are re-keyed when jobs.json is read. Most older records
+// are a bare ID with no link, so those keep their ID, and a fetched job is
+// also looked up under the IDs older versions would have given it.
+
+// linkIDPatterns recover a source's native ID from a stored link
+var linkIDPatterns = map[string]*regexp.Regexp{
+	"linkedin": linkedinJobIDRegex,
+	"indeed":   indeedJobKeyRegex,
+	"naukri":   naukriJobIDRegex,
+}
+
+// loopIndexRegex matches the suffix the old index fallback produced
+var loopIndexRegex = regexp.MustCompile(`^\d{1,3}$`)
+
+// migratedJobID returns the ID a stored job gets under makeJobID
+func migratedJobID(job Job) string {
+	prefix, suffix, _ := strings.Cut(job.ID, "-")
+	if prefix == "razorpay" && job.Link == "" && strings.HasPrefix(suffix, "http") {
+		job.Link = suffix // The ID was the link
+	}
+	if job.Link == "" {
+		return job.ID // Nothing to rebuild it from; see legacyJobIDs
+	}
+
+	if pattern, ok := linkIDPatterns[prefix]; ok {
+		if m := pattern.FindStringSubmatch(job.Link); m != nil {
+			return makeJobID(prefix, m[1], job.Link)
+		}
+		if loopIndexRegex.MatchString(suffix) {
+			return makeJobID(prefix, "", job.Link)
+		}
+		return job.ID
+	}
+
+	switch prefix {
+	case "razorpay", "triplebyte": // Whole URL and loop index respectively
+		return makeJobID(prefix, "", job.Link)
+	}
+
+	// Career pages hashed the link as scraped
+	slug := companySlug(job.Source)
+	if job.Link != "" && job.ID == slug+"-"+generateStableHash(job.Link) {
+		return makeJobID(slug, "", job.Link)
+	}
+	return job.ID
+}
+
+// The career-page scraper read IDs from links like this before makeJobID
+var (
+	legacyPageIDRegex      = regexp.MustCompile(`(?:jobs?|careers?|opportunities?|vacancies?)[/-]([a-zA-Z0-9_-]+)(?:[/-]|$)`)
+	legacyLastSegmentRegex = regexp.MustCompile(`[^/]+$`)
+)
+
+// legacyJobIDs returns the IDs older versions gave job, so it matches stored
+// records that have no link to re-key them by. Companies now read through an
+// ATS API were scraped from links, and Razorpay IDs were the whole link.
+func legacyJobIDs(job Job) []string {
+	if job.Link == "" {
+		return nil
+	}
+
+	var ids []string
+	if slug := companySlug(job.Source); slug != "" && strings.HasPrefix(job.ID, slug+"-") {
+		ids = append(ids, slug+"-"+legacyPageID(job.Link))
+	}
+	if strings.HasPrefix(job.ID, "razorpay-") {
+		ids = append(ids, "razorpay-"+job.Link)
+	}
+
+	var aliases []string
+	for _, id := range ids {
+		if id != job.ID {
+			aliases = append(aliases, id)
+		}
+	}
+	return aliases
+}
+
+// legacyPageID is the career-page job ID before makeJobID: the regex match,
+// else the last path segment, else a hash of the raw link
+func legacyPageID(link string) string {
+	var id string
+	if m := legacyPageIDRegex.FindStringSubmatch(link); len(m) > 1 {
+		id = m[1]
+	} else {
+		id = legacyLastSegmentRegex.FindString(link)
+	}
+	if id == "" {
+		id = generateStableHash(link)
+	}
+	return strings.NewReplacer("/", "", "?", "", "#", "").Replace(id)
+}
+
+// seenBefore reports whether the history has job under its ID or a legacy one
+func seenBefore(history map[string]JobRecord, job Job) bool {
+	if _, ok := history[job.ID]; ok {
+		return true
+	}
+	for _, id := range legacyJobIDs(job) {
+		if _, ok := history[id]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// migrateJobIDs re-keys records whose IDs predate makeJobID. Records that
+// turn out to be the same job are merged.
+func migrateJobIDs(records map[string]JobRecord) map[string]JobRecord {
+	ids := make([]string, 0, len(records))
+	for id := range records {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids) // Deterministic merge order
+
+	out := make(map[string]JobRecord, len(records))
+	changed := 0
+	for _, id := range ids {
+		r := records[id]
+		newID := migratedJobID(r.Job)
+		if newID != id {
+			changed++
+			r.Job.ID = newID
+		}
+		if existing, ok := out[newID]; ok {
+			r = mergeJobRecords(existing, r)
+		}
+		out[newID] = r
+	}
+
+	if changed > 0 {
+		fmt.Printf("Migrated %d job IDs to the stable format\n", changed)
+	}
+	return out
+}
+
+// mergeJobRecords combines two records of the same job, keeping the earliest
+// first sighting, the latest last sighting, the alert and the newest score
+func mergeJobRecords(a, b JobRecord) JobRecord {
+	if b.LastSeen > a.LastSeen {
+		a.Job = mergeJob(a.Job, b.Job)
+		a.LastSeen = b.LastSeen
+	}
+	if b.FirstSeen != 0 && (a.FirstSeen == 0 || b.FirstSeen < a.FirstSeen) {
+		a.FirstSeen = b.FirstSeen
+	}
+	if b.NotifiedAt > a.NotifiedAt {
+		a.NotifiedAt = b.NotifiedAt
+	}
+	if b.ScoredAt > a.ScoredAt {
+		a.AIScore, a.AIReason, a.ScoredAt = b.AIScore, b.AIReason, b.ScoredAt
+	}
+	return a
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestMakeJobID(t *testing.T) {
+	tests := []struct {
+		prefix, nativeID, link string
+		want                   string
+	}{
+		{"linkedin", "3912345678", "https://www.linkedin.com/jobs/view/3912345678", "linkedin-3912345678"},
+		{"figma", " 5756182004 ", "", "figma-5756182004"},
+		{"acme", "RedirectApply?jobId=1#top", "", "acme-RedirectApplyjobId=1top"},
+		{"acme", "", "https://acme.com/jobs/backend", "acme-" + generateStableHash("https://acme.com/jobs/backend")},
+	}
+	for _, tt := range tests {
+		if got := makeJobID(tt.prefix, tt.nativeID, tt.link); got != tt.want {
+			t.Errorf("makeJobID(%q, %q, %q) = %q, want %q", tt.prefix, tt.nativeID, tt.link, got, tt.want)
+		}
+	}
+}
+
+func TestCanonicalJobURL(t *testing.T) {
+	want := "https://acme.com/jobs/42?team=eng"
+	for _, link := range []string{
+		"https://acme.com/jobs/42?team=eng",
+		"http://www.ACME.com/jobs/42/?team=eng",
+		"https://acme.com/jobs/42?utm_source=linkedin&team=eng&ref=hn#apply",
+		" https://acme.com/jobs/42?gh_src=abc&team=eng ",
+	} {
+		if got := canonicalJobURL(link); got != want {
+			t.Errorf("canonicalJobURL(%q) = %q, want %q", link, got, want)
+		}
+	}
+
+	if makeJobID("acme", "", "https://acme.com/jobs/42?team=eng") == makeJobID("acme", "", "https://acme.com/jobs/43?team=eng") {
+		t.Errorf("different jobs hashed to the same ID")
+	}
+}
+
+func TestMigratedJobID(t *testing.T) {
+	tests := []struct {
+		name string
+		job  Job
+		want string
+	}{
+		{"linkedin with its link", Job{ID: "linkedin-7", Link: "https://www.linkedin.com/jobs/view/backend-engineer-at-acme-3912345678"}, "linkedin-3912345678"},
+		{"linkedin loop index without a link", Job{ID: "linkedin-7"}, "linkedin-7"},
+		{"razorpay whole URL", Job{ID: "razorpay-https://razorpay.com/jobs/jobs-all/"}, makeJobID("razorpay", "", "https://razorpay.com/jobs/jobs-all/")},
+		{"razorpay career page hash without a link", Job{ID: "razorpay-f989deace62c"}, "razorpay-f989deace62c"},
+		{"career page without a link", Job{ID: "tescotechnology-RedirectApplyjobId=190901", Source: "Tesco Technology"}, "tescotechnology-RedirectApplyjobId=190901"},
+		{"career page with a native ID", Job{ID: "tescotechnology-RedirectApplyjobId=191899", Source: "Tesco Technology", Link: "https://careers.tesco.com/en_GB/careers/RedirectApply?jobId=191899"}, "tescotechnology-RedirectApplyjobId=191899"},
+		{"career page hashed from the raw link", Job{ID: "acme-" + generateStableHash("https://www.acme.com/open/?utm_source=x"), Source: "Acme", Link: "https://www.acme.com/open/?utm_source=x"}, makeJobID("acme", "", "https://www.acme.com/open/?utm_source=x")},
+	}
+	for _, tt := range tests {
+		if got := migratedJobID(tt.job); got != tt.want {
+			t.Errorf("%s: migratedJobID(%q) = %q, want %q", tt.name, tt.job.ID, got, tt.want)
+		}
+	}
+}
+
+func TestLegacyJobIDs(t *testing.T) {
+	// IDs from the committed jobs.json, and the job each one is fetched as now
+	history := map[string]JobRecord{
+		"figma-5756182004gh_jid=5756182004":            {},
+		"tescotechnology-RedirectApplyjobId=190132":    {},
+		"razorpay-https://razorpay.com/jobs/jobs-all/": {},
+		"paytm-196ea019-2b72-4a1a-a976-a3edb2ca5e63":   {},
+	}
+	tests := []struct {
+		name string
+		job  Job
+		seen bool
+	}{
+		{"greenhouse adapter, scraped before", Job{
+			ID:     makeJobID("figma", "5756182004", ""),
+			Source: "Figma",
+			Link:   "https://boards.greenhouse.io/figma/jobs/5756182004?gh_jid=5756182004",
+		}, true},
+		{"scraped page, same ID as before", Job{
+			ID:     makeJobID("tescotechnology", "RedirectApply?jobId=190132", ""),
+			Source: "Tesco Technology",
+			Link:   "https://careers.tesco.com/en_GB/careers/RedirectApply?jobId=190132",
+		}, true},
+		{"razorpay whole URL", Job{
+			ID:     makeJobID("razorpay", "", "https://razorpay.com/jobs/jobs-all/"),
+			Source: "Razorpay",
+			Link:   "https://razorpay.com/jobs/jobs-all/",
+		}, true},
+		{"lever adapter, scraped before", Job{
+			ID:     makeJobID("paytm", "196ea019-2b72-4a1a-a976-a3edb2ca5e63", ""),
+			Source: "Paytm",
+			Link:   "https://jobs.lever.co/paytm/196ea019-2b72-4a1a-a976-a3edb2ca5e63",
+		}, true},
+		{"new greenhouse job", Job{
+			ID:     makeJobID("figma", "5999999999", ""),
+			Source: "Figma",
+			Link:   "https://boards.greenhouse.io/figma/jobs/5999999999?gh_jid=5999999999",
+		}, false},
+	}
+	for _, tt := range tests {
+		if got := seenBefore(history, tt.job); got != tt.seen {
+			t.Errorf("%s: seenBefore(%q) = %v, want %v (aliases %q)", tt.name, tt.job.ID, got, tt.seen, legacyJobIDs(tt.job))
+		}
+	}
+}
+
+// The committed history must come through migration with every record intact
+func TestMigrateCommittedJobsJSON(t *testing.T) {
+	data, err := os.ReadFile("jobs.json")
+	if err != nil {
+		t.Skip("no jobs.json")
+	}
+	var list []JobRecord
+	if err := json.Unmarshal(data, &list); err != nil {
+		t.Fatal(err)
+	}
+	records := map[string]JobRecord{}
+	for _, r := range list {
+		records[r.Job.ID] = r
+	}
+	raw := len(records)
+
+	migrated := migrateJobIDs(records)
+	if len(migrated) != raw {
+		t.Errorf("migration merged records: %d before, %d after", raw, len(migrated))
+	}
+	for id, r := range records {
+		if r.Job.Link != "" || strings.HasPrefix(id, "razorpay-http") {
+			continue // Re-keying is allowed when there's a link to rebuild from
+		}
+		if _, ok := migrated[id]; !ok {
+			t.Errorf("record %q without a link was re-keyed", id)
+		}
+	}
+}
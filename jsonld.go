@@ -0,0 +1,308 @@
+package main
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// ================== JSON-LD JOBPOSTING ==================
+// Career sites add schema.org JobPosting objects for Google for Jobs:
+// <script type="application/ld+json">{"@type": "JobPosting", ...}</script>
+// They carry the same structured fields as an ATS API, so they beat scraping
+// anchor text whenever they're present.
+
+// jobPostingsFromHTML returns a Job for every JobPosting in the page's JSON-LD.
+// Expired postings (validThrough in the past) are dropped. ID is the posting's
+// own identifier, if any, for the caller to prefix; Link falls back to pageURL.
+func jobPostingsFromHTML(doc *goquery.Document, pageURL string) []Job {
+	var jobs []Job
+	now := time.Now()
+
+	doc.Find(`script[type="application/ld+json"]`).Each(func(i int, s *goquery.Selection) {
+		var data interface{}
+		if err := json.Unmarshal([]byte(strings.TrimSpace(s.Text())), &data); err != nil {
+			return // Hand-written JSON-LD is often invalid; skip that block
+		}
+
+		for _, posting := range findJobPostings(data) {
+			if until := parseLDDate(ldString(posting["validThrough"])); !until.IsZero() && until.Before(now) {
+				continue
+			}
+			if job, ok := jobFromPosting(posting, pageURL); ok {
+				jobs = append(jobs, job)
+			}
+		}
+	})
+
+	return jobs
+}
+
+// mergeJobPosting fills the fields of job that the posting knows and job doesn't.
+// ID, Link and Source are never changed; tags are added.
+func mergeJobPosting(job *Job, posting Job) {
+	if job.Title == "" {
+		job.Title = posting.Title
+	}
+	if job.Company == "" {
+		job.Company = posting.Company
+	}
+	if job.Location == "" {
+		job.Location = posting.Location
+	}
+	if job.WorkMode == "" {
+		job.WorkMode = posting.WorkMode
+	}
+	if !job.hasExperience() {
+		job.ExperienceMin, job.ExperienceMax = posting.ExperienceMin, posting.ExperienceMax
+	}
+	if job.EmploymentType == "" {
+		job.EmploymentType = posting.EmploymentType
+	}
+	if len(posting.Description) > len(job.Description) {
+		job.Description = posting.Description
+	}
+	if job.Salary == "" {
+		job.Salary = posting.Salary
+	}
+	if job.Date.IsZero() {
+		job.Date = posting.Date
+	}
+	for _, tag := range posting.Tags {
+		if !containsString(job.Tags, tag) {
+			job.Tags = append(job.Tags, tag)
+		}
+	}
+}
+
+// findJobPostings walks a JSON-LD value (object, array or @graph) for objects
+// whose @type is or includes JobPosting
+func findJobPostings(v interface{}) []map[string]interface{} {
+	switch t := v.(type) {
+	case []interface{}:
+		var postings []map[string]interface{}
+		for _, item := range t {
+			postings = append(postings, findJobPostings(item)...)
+		}
+		return postings
+	case map[string]interface{}:
+		if graph, ok := t["@graph"]; ok {
+			return findJobPostings(graph)
+		}
+		for _, typ := range ldStrings(t["@type"]) {
+			if typ == "JobPosting" {
+				return []map[string]interface{}{t}
+			}
+		}
+		// ItemList of postings, as used on some listing pages
+		if items, ok := t["itemListElement"]; ok {
+			var postings []map[string]interface{}
+			for _, item := range ldList(items) {
+				if m, ok := item.(map[string]interface{}); ok {
+					if inner, ok := m["item"]; ok {
+						item = inner
+					}
+				}
+				postings = append(postings, findJobPostings(item)...)
+			}
+			return postings
+		}
+	}
+	return nil
+}
+
+func jobFromPosting(p map[string]interface{}, pageURL string) (Job, bool) {
+	title := strings.TrimSpace(ldString(p["title"]))
+	if title == "" {
+		return Job{}, false
+	}
+
+	link := ldString(p["url"])
+	if link == "" {
+		link = pageURL
+	} else if base, err := url.Parse(pageURL); err == nil {
+		if ref, err := url.Parse(link); err == nil {
+			link = base.ResolveReference(ref).String()
+		}
+	}
+
+	location := ldLocation(p["jobLocation"])
+	workMode := detectWorkMode(location)
+	if strings.EqualFold(ldString(p["jobLocationType"]), "TELECOMMUTE") {
+		workMode = WorkModeRemote
+		if location == "" {
+			location = ldLocation(p["applicantLocationRequirements"])
+		}
+	}
+
+	expMin, expMax := ldExperience(p["experienceRequirements"])
+
+	return Job{
+		ID:             ldIdentifier(p["identifier"]),
+		Title:          title,
+		Link:           link,
+		Company:        ldString(p["hiringOrganization"]),
+		Location:       location,
+		WorkMode:       workMode,
+		ExperienceMin:  expMin,
+		ExperienceMax:  expMax,
+		EmploymentType: strings.Join(ldStrings(p["employmentType"]), ", "),
+		Description:    htmlToText(ldString(p["description"])),
+		Salary:         ldSalary(p["baseSalary"]),
+		Date:           parseLDDate(ldString(p["datePosted"])),
+	}, true
+}
+
+// ldList treats a single value as a one-element list
+func ldList(v interface{}) []interface{} {
+	if list, ok := v.([]interface{}); ok {
+		return list
+	}
+	if v == nil {
+		return nil
+	}
+	return []interface{}{v}
+}
+
+// ldString reads text from a JSON-LD value: a string, a number, or an object
+// with a name/value (e.g. hiringOrganization, identifier). Lists use the first.
+func ldString(v interface{}) string {
+	switch t := v.(type) {
+	case string:
+		return strings.TrimSpace(t)
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64)
+	case []interface{}:
+		if len(t) > 0 {
+			return ldString(t[0])
+		}
+	case map[string]interface{}:
+		for _, key := range []string{"name", "value", "@value"} {
+			if s := ldString(t[key]); s != "" {
+				return s
+			}
+		}
+	}
+	return ""
+}
+
+// ldIdentifier reads a posting's identifier. A PropertyValue carries the
+// organisation in name and the ID in value, so value comes first here.
+func ldIdentifier(v interface{}) string {
+	if m, ok := v.(map[string]interface{}); ok {
+		if s := ldString(m["value"]); s != "" {
+			return s
+		}
+	}
+	return ldString(v)
+}
+
+func ldStrings(v interface{}) []string {
+	var out []string
+	for _, item := range ldList(v) {
+		if s := ldString(item); s != "" {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// ldLocation joins every Place's address, e.g. "Bengaluru, Karnataka, IN; Pune, IN"
+func ldLocation(v interface{}) string {
+	var places []string
+	for _, item := range ldList(v) {
+		place, ok := item.(map[string]interface{})
+		if !ok {
+			if s := ldString(item); s != "" {
+				places = append(places, s)
+			}
+			continue
+		}
+
+		address, ok := place["address"].(map[string]interface{})
+		if !ok {
+			if s := ldString(place["address"]); s != "" {
+				places = append(places, s)
+			} else if s := ldString(place["name"]); s != "" {
+				places = append(places, s)
+			}
+			continue
+		}
+
+		joined := joinNonEmpty(", ",
+			ldString(address["addressLocality"]),
+			ldString(address["addressRegion"]),
+			ldString(address["addressCountry"]))
+		if joined != "" {
+			places = append(places, joined)
+		}
+	}
+	return strings.Join(places, "; ")
+}
+
+// ldExperience reads experienceRequirements: free text ("0-2 years") or an
+// OccupationalExperienceRequirements object with monthsOfExperience
+func ldExperience(v interface{}) (int, int) {
+	if m, ok := v.(map[string]interface{}); ok {
+		if months, ok := m["monthsOfExperience"].(float64); ok && months > 0 {
+			years := int(months) / 12
+			return years, 0
+		}
+		return parseExperienceRange(ldString(m["description"]))
+	}
+	return parseExperienceRange(ldString(v))
+}
+
+// ldSalary renders a MonetaryAmount, e.g. "INR 600000-1200000 / YEAR"
+func ldSalary(v interface{}) string {
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return ldString(v)
+	}
+
+	currency := ldString(m["currency"])
+	value, ok := m["value"].(map[string]interface{})
+	if !ok {
+		return joinNonEmpty(" ", currency, ldString(m["value"]))
+	}
+
+	amount := ldString(value["value"])
+	if min, max := ldString(value["minValue"]), ldString(value["maxValue"]); min != "" && max != "" {
+		amount = min + "-" + max
+	} else if amount == "" {
+		amount = min + max
+	}
+	if amount == "" {
+		return ""
+	}
+
+	salary := joinNonEmpty(" ", currency, amount)
+	if unit := ldString(value["unitText"]); unit != "" {
+		salary += " / " + unit
+	}
+	return salary
+}
+
+// parseLDDate accepts the ISO 8601 forms seen in the wild: full timestamps,
+// timestamps without a zone, and bare dates
+func parseLDDate(s string) time.Time {
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02T15:04", "2006-01-02"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
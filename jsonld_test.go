@@ -0,0 +1,171 @@
+package main
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+func TestJobPostingsFromHTML(t *testing.T) {
+	page := `<html><head>
+<script type="application/ld+json">{"@context": "https://schema.org", "@type": "Organization", "name": "Acme"}</script>
+<script type="application/ld+json">{"@context": "https://schema.org", "@graph": [
+  {"@type": "WebPage", "name": "Careers"},
+  {"@type": "JobPosting",
+   "title": "Software Engineer I",
+   "identifier": {"@type": "PropertyValue", "name": "Acme", "value": "REQ-123"},
+   "url": "/jobs/123",
+   "hiringOrganization": {"@type": "Organization", "name": "Acme"},
+   "jobLocation": [
+     {"@type": "Place", "address": {"addressLocality": "Bengaluru", "addressRegion": "KA", "addressCountry": "IN"}},
+     {"@type": "Place", "address": {"addressLocality": "Pune", "addressCountry": "IN"}}
+   ],
+   "employmentType": ["FULL_TIME", "INTERN"],
+   "experienceRequirements": "0-2 years",
+   "description": "<p>Build <b>things</b>.</p>",
+   "datePosted": "2024-05-01",
+   "validThrough": "2999-01-01T00:00:00Z"}
+]}</script>
+<script type="application/ld+json">[
+  {"@type": "JobPosting", "title": "Remote Engineer", "jobLocationType": "TELECOMMUTE",
+   "applicantLocationRequirements": {"@type": "Country", "name": "India"}},
+  {"@type": "JobPosting", "title": "Expired Engineer", "validThrough": "2020-01-01"},
+  {"@type": "JobPosting", "description": "no title"}
+]</script>
+<script type="application/ld+json">{"@type": "JobPosting", "title": broken</script>
+</head><body></body></html>`
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(page))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	jobs := jobPostingsFromHTML(doc, "https://careers.acme.com/search")
+	if len(jobs) != 2 {
+		t.Fatalf("jobPostingsFromHTML() returned %d jobs, want 2: %+v", len(jobs), jobs)
+	}
+
+	got := jobs[0]
+	want := Job{
+		ID:             "REQ-123",
+		Title:          "Software Engineer I",
+		Link:           "https://careers.acme.com/jobs/123",
+		Company:        "Acme",
+		Location:       "Bengaluru, KA, IN; Pune, IN",
+		ExperienceMin:  0,
+		ExperienceMax:  2,
+		EmploymentType: "FULL_TIME, INTERN",
+		Description:    "Build things.",
+		Date:           time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+	}
+	if got.ID != want.ID || got.Title != want.Title || got.Link != want.Link || got.Company != want.Company ||
+		got.Location != want.Location || got.ExperienceMin != want.ExperienceMin || got.ExperienceMax != want.ExperienceMax ||
+		got.EmploymentType != want.EmploymentType || got.Description != want.Description || !got.Date.Equal(want.Date) {
+		t.Errorf("jobPostingsFromHTML()[0] = %+v, want %+v", got, want)
+	}
+
+	remote := jobs[1]
+	if remote.Title != "Remote Engineer" || remote.WorkMode != WorkModeRemote || remote.Location != "India" {
+		t.Errorf("jobPostingsFromHTML()[1] = %+v, want a remote job in India", remote)
+	}
+	if remote.Link != "https://careers.acme.com/search" {
+		t.Errorf("jobPostingsFromHTML()[1].Link = %q, want the page URL", remote.Link)
+	}
+}
+
+// decodeLD parses a JSON-LD fragment the way jobPostingsFromHTML does
+func decodeLD(t *testing.T, s string) interface{} {
+	t.Helper()
+	var v interface{}
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		t.Fatalf("bad test JSON %s: %v", s, err)
+	}
+	return v
+}
+
+func TestLDExperience(t *testing.T) {
+	tests := []struct {
+		value    string
+		min, max int
+	}{
+		{`"0-2 years"`, 0, 2},
+		{`"3+ years of experience"`, 3, 0},
+		{`"Experience with Go"`, 0, 0},
+		{`{"@type": "OccupationalExperienceRequirements", "monthsOfExperience": 24}`, 2, 0},
+		{`{"@type": "OccupationalExperienceRequirements", "monthsOfExperience": 0, "description": "1 to 3 years"}`, 1, 3},
+		{`null`, 0, 0},
+	}
+
+	for _, tt := range tests {
+		min, max := ldExperience(decodeLD(t, tt.value))
+		if min != tt.min || max != tt.max {
+			t.Errorf("ldExperience(%s) = (%d, %d), want (%d, %d)", tt.value, min, max, tt.min, tt.max)
+		}
+	}
+}
+
+func TestLDSalary(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{`{"@type": "MonetaryAmount", "currency": "INR", "value": {"@type": "QuantitativeValue", "minValue": 600000, "maxValue": 1200000, "unitText": "YEAR"}}`, "INR 600000-1200000 / YEAR"},
+		{`{"@type": "MonetaryAmount", "currency": "USD", "value": {"@type": "QuantitativeValue", "value": 45, "unitText": "HOUR"}}`, "USD 45 / HOUR"},
+		{`{"@type": "MonetaryAmount", "currency": "INR", "value": {"@type": "QuantitativeValue", "minValue": 50000}}`, "INR 50000"},
+		{`{"@type": "MonetaryAmount", "currency": "INR", "value": 800000}`, "INR 800000"},
+		{`{"@type": "MonetaryAmount", "currency": "INR", "value": {"@type": "QuantitativeValue", "unitText": "YEAR"}}`, ""},
+		{`"Competitive"`, "Competitive"},
+		{`null`, ""},
+	}
+
+	for _, tt := range tests {
+		if got := ldSalary(decodeLD(t, tt.value)); got != tt.want {
+			t.Errorf("ldSalary(%s) = %q, want %q", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestParseLDDate(t *testing.T) {
+	ist := time.FixedZone("", 5*60*60+30*60)
+
+	tests := []struct {
+		s    string
+		want time.Time
+	}{
+		{"2024-05-01T10:30:00+05:30", time.Date(2024, 5, 1, 10, 30, 0, 0, ist)},
+		{"2024-05-01T10:30:00Z", time.Date(2024, 5, 1, 10, 30, 0, 0, time.UTC)},
+		{"2024-05-01T10:30:00", time.Date(2024, 5, 1, 10, 30, 0, 0, time.UTC)},
+		{"2024-05-01T10:30", time.Date(2024, 5, 1, 10, 30, 0, 0, time.UTC)},
+		{"2024-05-01", time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)},
+		{"May 1, 2024", time.Time{}},
+		{"", time.Time{}},
+	}
+
+	for _, tt := range tests {
+		if got := parseLDDate(tt.s); !got.Equal(tt.want) {
+			t.Errorf("parseLDDate(%q) = %v, want %v", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestLDIdentifier(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{`{"@type": "PropertyValue", "name": "Acme", "value": "REQ-123"}`, "REQ-123"},
+		{`{"@type": "PropertyValue", "value": 4567}`, "4567"},
+		{`{"@type": "PropertyValue", "name": "REQ-9"}`, "REQ-9"},
+		{`"REQ-1"`, "REQ-1"},
+		{`null`, ""},
+	}
+
+	for _, tt := range tests {
+		if got := ldIdentifier(decodeLD(t, tt.value)); got != tt.want {
+			t.Errorf("ldIdentifier(%s) = %q, want %q", tt.value, got, tt.want)
+		}
+	}
+}
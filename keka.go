@@ -0,0 +1,119 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// ================== KEKA HIRE ==================
+// Career pages on {tenant}.keka.com/careers load openings from
+// GET /careers/api/embedjobs/default/active/{embedCode}
+
+func init() {
+	registerATS("keka", fetchKekaJobs)
+}
+
+type kekaJob struct {
+	ID            string `json:"id"`
+	Title         string `json:"title"`
+	Description   string `json:"description"`
+	Department    string `json:"departmentName"`
+	JobType       string `json:"jobType"`
+	MinExperience int    `json:"minExperience"`
+	MaxExperience int    `json:"maxExperience"`
+	IsRemote      bool   `json:"isRemote"`
+	PublishedOn   string `json:"publishedOn"`
+	JobLocations  []struct {
+		City        string `json:"city"`
+		State       string `json:"state"`
+		CountryName string `json:"countryName"`
+	} `json:"jobLocations"`
+}
+
+var kekaEmbedCodeRegex = regexp.MustCompile(`embedjobs/(?:default/)?active/([0-9a-fA-F-]{36})`)
+
+// fetchKekaJobs reads a Keka career page's active openings. company.Token is
+// the embed code; when empty it is read from the career page itself.
+func fetchKekaJobs(ctx context.Context, company CompanyCareer) ([]Job, error) {
+	u, err := url.Parse(company.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	embedCode := company.Token
+	if embedCode == "" {
+		if embedCode, err = findKekaEmbedCode(ctx, fmt.Sprintf("https://%s/careers/", u.Host)); err != nil {
+			return nil, fmt.Errorf("keka %s: %w", u.Host, err)
+		}
+	}
+
+	apiURL := fmt.Sprintf("https://%s/careers/api/embedjobs/default/active/%s", u.Host, embedCode)
+
+	var data []kekaJob
+	if err := getJSON(ctx, apiURL, &data); err != nil {
+		return nil, fmt.Errorf("keka %s: %w", u.Host, err)
+	}
+
+	var jobs []Job
+	for _, j := range data {
+		if !isEntryLevelJob(j.Title) {
+			continue
+		}
+
+		var locations []string
+		for _, l := range j.JobLocations {
+			locations = append(locations, joinNonEmpty(", ", l.City, l.State, l.CountryName))
+		}
+		location := strings.Join(locations, "; ")
+
+		workMode := detectWorkMode(location)
+		if j.IsRemote {
+			workMode = WorkModeRemote
+		}
+
+		var tags []string
+		if j.Department != "" {
+			tags = append(tags, j.Department)
+		}
+
+		date, _ := time.Parse(time.RFC3339, j.PublishedOn)
+		if date.IsZero() {
+			date, _ = time.Parse("2006-01-02T15:04:05", strings.SplitN(j.PublishedOn, ".", 2)[0])
+		}
+
+		link := fmt.Sprintf("https://%s/careers/jobdetails/%s", u.Host, j.ID)
+		jobs = append(jobs, Job{
+			ID:             makeJobID(companySlug(company.Name), j.ID, link),
+			Title:          j.Title,
+			Link:           link,
+			Source:         company.Name,
+			Company:        company.Name,
+			Location:       location,
+			WorkMode:       workMode,
+			ExperienceMin:  j.MinExperience,
+			ExperienceMax:  j.MaxExperience,
+			EmploymentType: j.JobType,
+			Description:    htmlToText(j.Description),
+			Tags:           tags,
+			Date:           date,
+		})
+	}
+
+	return jobs, nil
+}
+
+// findKekaEmbedCode reads the jobs widget's embed code from a career page
+func findKekaEmbedCode(ctx context.Context, pageURL string) (string, error) {
+	body, err := httpGet(ctx, pageURL, profileBrowser)
+	if err != nil {
+		return "", err
+	}
+	if m := kekaEmbedCodeRegex.FindSubmatch(body); m != nil {
+		return string(m[1]), nil
+	}
+	return "", fmt.Errorf("no embed code on %s (set token)", pageURL)
+}
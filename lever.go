@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ================== LEVER ==================
+// Public postings API: api.lever.co/v0/postings/{company}?mode=json
+
+func init() {
+	registerATS("lever", fetchLeverJobs)
+}
+
+type leverPosting struct {
+	ID               string `json:"id"`
+	Text             string `json:"text"`
+	HostedURL        string `json:"hostedUrl"`
+	CreatedAt        int64  `json:"createdAt"` // Unix milliseconds
+	WorkplaceType    string `json:"workplaceType"`
+	DescriptionPlain string `json:"descriptionPlain"`
+	Categories       struct {
+		Team         string   `json:"team"`
+		Department   string   `json:"department"`
+		Location     string   `json:"location"`
+		Commitment   string   `json:"commitment"`
+		AllLocations []string `json:"allLocations"`
+	} `json:"categories"`
+}
+
+// fetchLeverJobs reads every published posting for a Lever company.
+// company.Token is the site name, e.g. "paytm" for jobs.lever.co/paytm.
+func fetchLeverJobs(ctx context.Context, company CompanyCareer) ([]Job, error) {
+	if company.Token == "" {
+		return nil, fmt.Errorf("lever: no site name for %s", company.Name)
+	}
+
+	url := fmt.Sprintf("https://api.lever.co/v0/postings/%s?mode=json", company.Token)
+
+	var postings []leverPosting
+	if err := getJSON(ctx, url, &postings); err != nil {
+		return nil, fmt.Errorf("lever %s: %w", company.Token, err)
+	}
+
+	var jobs []Job
+	for _, p := range postings {
+		if !isEntryLevelJob(p.Text) {
+			continue
+		}
+
+		location := p.Categories.Location
+		if len(p.Categories.AllLocations) > 1 {
+			location = strings.Join(p.Categories.AllLocations, ", ")
+		}
+
+		var tags []string
+		for _, t := range []string{p.Categories.Department, p.Categories.Team} {
+			if t != "" {
+				tags = append(tags, t)
+			}
+		}
+
+		var date time.Time
+		if p.CreatedAt > 0 {
+			date = time.UnixMilli(p.CreatedAt)
+		}
+
+		jobs = append(jobs, Job{
+			ID:             makeJobID(companySlug(company.Name), p.ID, p.HostedURL),
+			Title:          p.Text,
+			Link:           p.HostedURL,
+			Source:         company.Name,
+			Company:        company.Name,
+			Location:       location,
+			WorkMode:       leverWorkMode(p.WorkplaceType, location),
+			EmploymentType: p.Categories.Commitment,
+			Description:    strings.TrimSpace(p.DescriptionPlain),
+			Tags:           tags,
+			Date:           date,
+		})
+	}
+
+	return jobs, nil
+}
+
+// leverWorkMode maps Lever's workplaceType, falling back to the location text
+// when the company left it "unspecified"
+func leverWorkMode(workplaceType, location string) WorkMode {
+	switch workplaceType {
+	case "remote":
+		return WorkModeRemote
+	case "hybrid":
+		return WorkModeHybrid
+	case "on-site", "onsite":
+		return WorkModeOnsite
+	}
+	return detectWorkMode(location)
+}
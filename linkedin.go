@@ -1,41 +1,77 @@
 package main
 
 import (
+	"bytes"
+	"context"
 	"fmt"
-	"io"
-	"net/http"
+	"net/url"
 	"regexp"
 	"strings"
-
 	"time"
 
 	"github.com/PuerkitoBio/goquery"
 )
 
-// LinkedIn search configuration
+func init() {
+	registerSource(newSource("LinkedIn", "linkedin", fetchLinkedInJobs))
+}
+
+// LinkedInConfig is the `linkedin:` block in config.yaml
+type LinkedInConfig struct {
+	Searches         []LinkedInSearch `yaml:"searches"`
+	MaxPages         int              `yaml:"max_pages"`          // Result pages per search (25 jobs each)
+	PageDelaySeconds int              `yaml:"page_delay_seconds"` // Pause between pages of a search
+}
+
+// LinkedIn search configuration. Empty fields are left out of the query.
 type LinkedInSearch struct {
-	Keywords   string
-	Location   string
-	TimePosted string // r86400 = past 24h, r604800 = past week
+	Keywords   string   `yaml:"keywords"`
+	Location   string   `yaml:"location"`    // Free text, e.g. "India"
+	GeoID      string   `yaml:"geo_id"`      // LinkedIn's location ID, e.g. 102713980 for India; more precise than location
+	TimePosted string   `yaml:"time_posted"` // 24h, week, month, or a raw f_TPR value such as r86400
+	Experience []string `yaml:"experience"`  // f_E: 1 internship, 2 entry level, 3 associate, 4 mid-senior
+	WorkType   []string `yaml:"work_type"`   // f_WT: 1 on-site, 2 remote, 3 hybrid
+	JobType    []string `yaml:"job_type"`    // f_JT: F full-time, P part-time, C contract, I internship
+}
+
+const (
+	linkedinPageSize         = 25
+	defaultLinkedInMaxPages  = 3
+	defaultLinkedInPageDelay = 2
+)
+
+// defaultLinkedInSearches are used when config.yaml doesn't list any
+var defaultLinkedInSearches = []LinkedInSearch{
+	{Keywords: "software engineer", Location: "India", TimePosted: "24h"},
+	{Keywords: "backend developer", Location: "India", TimePosted: "24h"},
+	{Keywords: "full stack developer", Location: "India", TimePosted: "24h"},
+	{Keywords: "react developer", Location: "India", TimePosted: "24h"},
+	{Keywords: "node.js developer", Location: "India", TimePosted: "24h"},
+	{Keywords: "software engineer", TimePosted: "24h", WorkType: []string{"2"}}, // Remote
 }
 
-var linkedinSearches = []LinkedInSearch{
-	{Keywords: "software engineer", Location: "India", TimePosted: "r86400"},
-	{Keywords: "backend developer", Location: "India", TimePosted: "r86400"},
-	{Keywords: "full stack developer", Location: "India", TimePosted: "r86400"},
-	{Keywords: "react developer", Location: "India", TimePosted: "r86400"},
-	{Keywords: "node.js developer", Location: "India", TimePosted: "r86400"},
-	{Keywords: "software engineer", Location: "", TimePosted: "r86400"}, // Remote
+// linkedinTimePosted maps friendly windows to f_TPR values
+var linkedinTimePosted = map[string]string{
+	"24h":   "r86400",
+	"day":   "r86400",
+	"week":  "r604800",
+	"month": "r2592000",
 }
 
-func fetchLinkedInJobs() ([]Job, error) {
-	var allJobs []Job
+func fetchLinkedInJobs(ctx context.Context) ([]Job, error) {
+	searches := cfg.LinkedIn.Searches
+	if len(searches) == 0 {
+		searches = defaultLinkedInSearches
+	}
 
-	for _, search := range linkedinSearches {
-		jobs, err := scrapeLinkedInSearch(search)
+	var allJobs []Job
+	for _, search := range searches {
+		if ctx.Err() != nil {
+			break
+		}
+		jobs, err := scrapeLinkedInSearch(ctx, search, cfg.LinkedIn)
 		if err != nil {
 			fmt.Printf("  Warning: LinkedIn search failed for '%s': %v\n", search.Keywords, err)
-			continue
 		}
 		allJobs = append(allJobs, jobs...)
 	}
@@ -53,54 +89,96 @@ func fetchLinkedInJobs() ([]Job, error) {
 	return unique, nil
 }
 
-func scrapeLinkedInSearch(search LinkedInSearch) ([]Job, error) {
-	// LinkedIn guest jobs API endpoint
-	baseURL := "https://www.linkedin.com/jobs-guest/jobs/api/seeMoreJobPostings/search"
-
-	// Build query parameters
-	keywords := strings.ReplaceAll(search.Keywords, " ", "%20")
-	location := strings.ReplaceAll(search.Location, " ", "%20")
-
-	url := fmt.Sprintf("%s?keywords=%s&location=%s&f_TPR=%s&start=0",
-		baseURL, keywords, location, search.TimePosted)
-
-	req, _ := http.NewRequest("GET", url, nil)
-	// Emulate a real modern browser
-	req.Header.Set("User-Agent", "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/121.0.0.0 Safari/537.36")
-	req.Header.Set("Accept", "text/html,application/xhtml+xml,application/xml;q=0.9,image/avif,image/webp,image/apng,*/*;q=0.8,application/signed-exchange;v=b3;q=0.7")
-	req.Header.Set("Accept-Language", "en-US,en;q=0.9")
-	req.Header.Set("Sec-Ch-Ua", `"Not A(Brand";v="99", "Google Chrome";v="121", "Chromium";v="121"`)
-	req.Header.Set("Sec-Ch-Ua-Mobile", "?0")
-	req.Header.Set("Sec-Ch-Ua-Platform", `"macOS"`)
-	req.Header.Set("Sec-Fetch-Dest", "document")
-	req.Header.Set("Sec-Fetch-Mode", "navigate")
-	req.Header.Set("Sec-Fetch-Site", "none")
-	req.Header.Set("Sec-Fetch-User", "?1")
-	req.Header.Set("Upgrade-Insecure-Requests", "1")
-
-	// Use a client with timeout - reduced from 30s to 15s
-	client := &http.Client{
-		Timeout: 15 * time.Second,
+// linkedinSearchURL builds the guest API query for one page of a search
+func linkedinSearchURL(search LinkedInSearch, start int) string {
+	params := url.Values{}
+	params.Set("keywords", search.Keywords)
+	if search.Location != "" {
+		params.Set("location", search.Location)
+	}
+	if search.GeoID != "" {
+		params.Set("geoId", search.GeoID)
+	}
+	if search.TimePosted != "" {
+		tpr := search.TimePosted
+		if mapped, ok := linkedinTimePosted[strings.ToLower(tpr)]; ok {
+			tpr = mapped
+		}
+		params.Set("f_TPR", tpr)
+	}
+	if len(search.Experience) > 0 {
+		params.Set("f_E", strings.Join(search.Experience, ","))
+	}
+	if len(search.WorkType) > 0 {
+		params.Set("f_WT", strings.Join(search.WorkType, ","))
 	}
+	if len(search.JobType) > 0 {
+		params.Set("f_JT", strings.Join(search.JobType, ","))
+	}
+	params.Set("start", fmt.Sprintf("%d", start))
 
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, err
+	return "https://www.linkedin.com/jobs-guest/jobs/api/seeMoreJobPostings/search?" + params.Encode()
+}
+
+// scrapeLinkedInSearch pages through one search until a page adds nothing new
+// or max_pages is reached. Jobs from pages before a failure are kept.
+func scrapeLinkedInSearch(ctx context.Context, search LinkedInSearch, c LinkedInConfig) ([]Job, error) {
+	maxPages := c.MaxPages
+	if maxPages <= 0 {
+		maxPages = defaultLinkedInMaxPages
 	}
-	defer resp.Body.Close()
+	delay := time.Duration(c.PageDelaySeconds) * time.Second
+	if c.PageDelaySeconds <= 0 {
+		delay = defaultLinkedInPageDelay * time.Second
+	}
+
+	var jobs []Job
+	seen := make(map[string]bool)
+
+	for page := 0; page < maxPages; page++ {
+		if page > 0 {
+			// LinkedIn rate-limits (429) fast paging
+			if err := sleepContext(ctx, delay); err != nil {
+				return jobs, err
+			}
+		}
+
+		pageJobs, err := scrapeLinkedInPage(ctx, linkedinSearchURL(search, page*linkedinPageSize))
+		if err != nil {
+			return jobs, err
+		}
+
+		added := 0
+		for _, j := range pageJobs {
+			if !seen[j.ID] {
+				seen[j.ID] = true
+				jobs = append(jobs, j)
+				added++
+			}
+		}
+		if added == 0 {
+			break // Past the last page
+		}
+	}
+
+	return jobs, nil
+}
+
+// linkedinJobIDRegex reads the ID from /jobs/view/123 and /jobs/view/backend-engineer-at-acme-123
+var linkedinJobIDRegex = regexp.MustCompile(`/jobs/view/(?:[^/?]*-)?(\d+)`)
 
-	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("status %d", resp.StatusCode)
+func scrapeLinkedInPage(ctx context.Context, pageURL string) ([]Job, error) {
+	body, err := httpGet(ctx, pageURL, profileBrowser)
+	if err != nil {
+		return nil, err
 	}
 
-	body, _ := io.ReadAll(resp.Body)
-	doc, err := goquery.NewDocumentFromReader(strings.NewReader(string(body)))
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
 	if err != nil {
 		return nil, err
 	}
 
 	var jobs []Job
-	jobIDRegex := regexp.MustCompile(`/jobs/view/(\d+)`)
 
 	// Parse job cards from LinkedIn HTML
 	doc.Find("li, .base-card, .job-search-card").Each(func(i int, s *goquery.Selection) {
@@ -122,12 +200,17 @@ func scrapeLinkedInSearch(search LinkedInSearch) ([]Job, error) {
 			return
 		}
 
-		// Extract job ID from URL
+		// Extract job ID from URL, else from the card's urn:li:jobPosting:123
+		urn, _ := s.Attr("data-entity-urn")
+		if urn == "" {
+			urn, _ = s.Find("[data-entity-urn]").First().Attr("data-entity-urn")
+		}
+
 		var jobID string
-		if matches := jobIDRegex.FindStringSubmatch(link); len(matches) > 1 {
+		if matches := linkedinJobIDRegex.FindStringSubmatch(link); len(matches) > 1 {
 			jobID = matches[1]
-		} else {
-			jobID = fmt.Sprintf("%d", i)
+		} else if urn != "" {
+			jobID = urn[strings.LastIndex(urn, ":")+1:]
 		}
 
 		// Get company name
@@ -138,20 +221,21 @@ func scrapeLinkedInSearch(search LinkedInSearch) ([]Job, error) {
 		location := s.Find(".job-search-card__location, .base-search-card__metadata span").First().Text()
 		location = strings.TrimSpace(location)
 
-		// Combine title with company if available
-		fullTitle := title
-		if company != "" {
-			fullTitle = fmt.Sprintf("%s @ %s", title, company)
-		}
-		if location != "" {
-			fullTitle = fmt.Sprintf("%s (%s)", fullTitle, location)
+		// <time class="job-search-card__listdate" datetime="2024-01-15">
+		var posted time.Time
+		if datetime, ok := s.Find("time[datetime]").First().Attr("datetime"); ok {
+			posted, _ = time.Parse("2006-01-02", datetime)
 		}
 
 		jobs = append(jobs, Job{
-			ID:     "linkedin-" + jobID,
-			Title:  fullTitle,
-			Link:   link,
-			Source: "LinkedIn",
+			ID:       makeJobID("linkedin", jobID, link),
+			Title:    title,
+			Link:     link,
+			Source:   "LinkedIn",
+			Company:  company,
+			Location: location,
+			WorkMode: detectWorkMode(location + " " + title),
+			Date:     posted,
 		})
 	})
 
@@ -1,13 +1,16 @@
 package main
 
 import (
-	"bytes"
-	"encoding/json"
+	"context"
+	"flag"
 	"fmt"
 	"net/http"
 	"net/url"
 	"os"
+	"os/signal"
+	"strings"
 	"sync"
+	"syscall"
 	"time"
 
 	"github.com/joho/godotenv"
@@ -20,10 +23,18 @@ type Config struct {
 	ExcludeKeywords    []string        `yaml:"exclude_keywords"`
 	MaxExperienceYears int             `yaml:"max_experience_years"`
 	IndeedRSS          []string        `yaml:"indeed_rss"`
+	Feeds              []FeedConfig    `yaml:"feeds"` // RSS/Atom job feeds (feeds source)
 	Sources            map[string]bool `yaml:"sources"`
 	AI                 AIConfig        `yaml:"ai"`             // New AI config
 	RetentionDays      int             `yaml:"retention_days"` // Days to keep job history
 	MaxDaysOld         int             `yaml:"max_days_old"`   // Filter jobs older than X days
+	Storage            StorageConfig   `yaml:"storage"`        // Where job history is kept
+	Companies          CompaniesConfig `yaml:"companies"`      // Which catalogue entries to scan
+	Details            DetailsConfig   `yaml:"details"`        // Detail page fetching for shortlisted jobs
+	LinkedIn           LinkedInConfig  `yaml:"linkedin"`       // LinkedIn searches and paging
+	HTTP               HTTPConfig      `yaml:"http"`           // Shared fetcher: timeouts, retries, rate limits
+	Deadlines          DeadlinesConfig `yaml:"deadlines"`      // Overall run deadline and per-stage budgets
+	Health             HealthConfig    `yaml:"health"`         // Circuit breakers for failing sources and pages
 }
 
 var cfg Config
@@ -50,90 +61,62 @@ func loadConfig() Config {
 		c.RetentionDays = 30
 	}
 
-	return c
-}
-
-// JobRecord stores job with timestamp for tracking when it was first seen
-type JobRecord struct {
-	Job       Job   `json:"job"`
-	FirstSeen int64 `json:"first_seen"` // Unix timestamp
-}
-
-func loadOldJobs() map[string]int64 {
-	file, err := os.ReadFile("jobs.json")
-	if err != nil {
-		return map[string]int64{}
+	if c.Details.MaxJobs == 0 {
+		c.Details.MaxJobs = defaultDetailMaxJobs
 	}
-
-	// Try new format first (with timestamps)
-	var records []JobRecord
-	if err := json.Unmarshal(file, &records); err == nil && len(records) > 0 {
-		seen := map[string]int64{}
-		for _, r := range records {
-			seen[r.Job.ID] = r.FirstSeen
-		}
-		return seen
+	if c.Details.Concurrency == 0 {
+		c.Details.Concurrency = defaultDetailConcurrency
 	}
-
-	// Fallback: old format (just jobs without timestamps)
-	var jobs []Job
-	json.Unmarshal(file, &jobs)
-
-	seen := map[string]int64{}
-	now := time.Now().Unix()
-	for _, j := range jobs {
-		seen[j.ID] = now // Assume they were seen now
+	if c.Details.CachePath == "" {
+		c.Details.CachePath = defaultDetailCachePath
+	}
+	if c.Details.Attempts <= 0 {
+		c.Details.Attempts = defaultDetailAttempts
 	}
-	return seen
-}
-
-func saveJobRecords(jobs []Job, existingRecords map[string]int64) {
-	now := time.Now().Unix()
-	var records []JobRecord
 
-	// Calculate cutoff time for retention
-	retentionDays := cfg.RetentionDays
-	if retentionDays <= 0 {
-		retentionDays = 30 // Safety fallback
+	if c.Deadlines.RunMinutes <= 0 {
+		c.Deadlines.RunMinutes = defaultRunMinutes
+	}
+	if c.Deadlines.FetchSeconds <= 0 {
+		c.Deadlines.FetchSeconds = defaultFetchSeconds
+	}
+	if c.Deadlines.DetailsSeconds <= 0 {
+		c.Deadlines.DetailsSeconds = defaultDetailsSeconds
+	}
+	if c.Deadlines.AISeconds <= 0 {
+		c.Deadlines.AISeconds = defaultAISeconds
+	}
+	if c.Deadlines.NotifySeconds <= 0 {
+		c.Deadlines.NotifySeconds = defaultNotifySeconds
 	}
-	cutoff := now - int64(retentionDays*24*60*60)
 
-	// Add existing jobs with their original timestamps
-	for id, ts := range existingRecords {
-		// Cleanup: Skip references older than retention period
-		if ts < cutoff {
-			continue
-		}
+	return c
+}
 
-		// Keep record even if not in current fetch
-		records = append(records, JobRecord{
-			Job:       Job{ID: id},
-			FirstSeen: ts,
-		})
-	}
+// sendTelegram delivers job alerts to TG_CHAT
+func sendTelegram(ctx context.Context, msg string) error {
+	return sendTelegramTo(ctx, os.Getenv("TG_CHAT"), msg)
+}
 
-	// Add new jobs
-	for _, j := range jobs {
-		if _, exists := existingRecords[j.ID]; !exists {
-			records = append(records, JobRecord{
-				Job:       j,
-				FirstSeen: now,
-			})
-		}
+// sendAdminTelegram delivers maintenance alerts to TG_ADMIN_CHAT, or to
+// TG_CHAT as a message of their own when no admin chat is set
+func sendAdminTelegram(ctx context.Context, msg string) error {
+	chat := os.Getenv("TG_ADMIN_CHAT")
+	if chat == "" {
+		chat = os.Getenv("TG_CHAT")
 	}
-
-	data, _ := json.MarshalIndent(records, "", "  ")
-	os.WriteFile("jobs.json", data, 0644)
+	return sendTelegramTo(ctx, chat, msg)
 }
 
-func sendTelegram(msg string) {
+// sendTelegramTo delivers msg to chat, splitting it to fit Telegram's size
+// limit. It returns an error if nothing could be delivered.
+func sendTelegramTo(ctx context.Context, chat, msg string) error {
 	token := os.Getenv("TG_TOKEN")
-	chat := os.Getenv("TG_CHAT")
 
 	if token == "" || chat == "" {
 		fmt.Println("Warning: TG_TOKEN or TG_CHAT not set, skipping Telegram notification")
 		fmt.Println(msg)
-		return
+		return fmt.Errorf("telegram credentials not set")
 	}
 
 	// Telegram has a 4096 character limit per message
@@ -164,12 +147,19 @@ func sendTelegram(msg string) {
 
 	apiURL := fmt.Sprintf("https://api.telegram.org/bot%s/sendMessage", token)
 
+	delivered := 0
 	for i, m := range messages {
 		data := fmt.Sprintf("chat_id=%s&text=%s&disable_web_page_preview=true",
 			chat,
 			url.QueryEscape(m))
 
-		resp, err := http.Post(apiURL, "application/x-www-form-urlencoded", bytes.NewBufferString(data))
+		req, err := http.NewRequestWithContext(ctx, "POST", apiURL, strings.NewReader(data))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		resp, err := http.DefaultClient.Do(req)
 		if err != nil {
 			fmt.Printf("Error sending Telegram message %d: %v\n", i+1, err)
 			continue
@@ -178,12 +168,23 @@ func sendTelegram(msg string) {
 
 		if resp.StatusCode != 200 {
 			fmt.Printf("Telegram API returned status %d for message %d\n", resp.StatusCode, i+1)
+			continue
 		}
+		delivered++
+	}
+	fmt.Printf("Sent %d Telegram message(s)\n", delivered)
+
+	if delivered == 0 {
+		return fmt.Errorf("no telegram messages delivered")
 	}
-	fmt.Printf("Sent %d Telegram message(s)\n", len(messages))
+	return nil
 }
 
 func main() {
+	testSources := flag.Bool("test-sources", false, "Probe every source and company page in isolation and write a status report (no notifications, jobs.json untouched)")
+	reportDir := flag.String("report-dir", ".", "Directory for the --test-sources report files")
+	flag.Parse()
+
 	// Load .env file for Telegram credentials
 	if err := godotenv.Load(); err != nil {
 		fmt.Println("Note: No .env file found, using environment variables")
@@ -191,185 +192,129 @@ func main() {
 
 	// Load configuration
 	cfg = loadConfig()
+	fetcher = newFetcher(cfg.HTTP)
+
+	// Ctrl-C or a cancelled workflow stops the run the way a deadline does
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 
 	// Initialize keywords from config
 	if len(cfg.Keywords) > 0 {
 		initKeywords(cfg)
 	}
 
-	old := loadOldJobs()
-	fmt.Printf("Loaded %d previously seen jobs for deduplication\n", len(old))
-
-	// Fetch from all sources in parallel for speed
-	fmt.Println("🚀 Fetching jobs in parallel...")
-	startTime := time.Now()
-
-	var jobs []Job
-	var mu sync.Mutex
-	var wg sync.WaitGroup
-
-	// Helper to add jobs safely
-	addJobs := func(source string, newJobs []Job) {
-		mu.Lock()
-		jobs = append(jobs, newJobs...)
-		fmt.Printf("  ✓ %s: %d jobs\n", source, len(newJobs))
-		mu.Unlock()
-	}
-
-	// RemoteOK
-	if cfg.Sources["remoteok"] {
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			if remoteJobs, err := fetchJobs(); err == nil {
-				addJobs("RemoteOK", remoteJobs)
-			}
-		}()
+	// The catalogue is only read (and a bad one only stops the run) when the
+	// companies source is on or detect is adding to it
+	var catalog []CompanyCareer
+	if cfg.Sources["companies"] || flag.Arg(0) == "detect" {
+		var err error
+		if catalog, err = loadCompanyCatalog(cfg.Companies); err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
 	}
-
-	// Razorpay
-	if cfg.Sources["razorpay"] {
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			if razorpayJobs, err := fetchRazorpayJobs(); err == nil {
-				addJobs("Razorpay", razorpayJobs)
-			}
-		}()
+	if cfg.Sources["companies"] {
+		companyCareerPages = selectCompanies(catalog, cfg.Companies)
+		if len(companyCareerPages) == 0 {
+			fmt.Printf("Warning: no companies match the companies filters in config.yaml. Available tags: %s\n", strings.Join(catalogTags(catalog), ", "))
+		}
 	}
 
-	// Wellfound
-	if cfg.Sources["wellfound"] {
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			if wellfoundJobs, err := fetchWellfoundJobs(); err == nil {
-				addJobs("Wellfound", wellfoundJobs)
-			}
-		}()
+	if flag.Arg(0) == "detect" {
+		if err := runDetectCommand(ctx, flag.Args()[1:], catalog); err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		return
 	}
 
-	// Indeed
-	if cfg.Sources["indeed"] {
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			if indeedJobs, err := fetchIndeedJobs(cfg.IndeedRSS); err == nil {
-				addJobs("Indeed", indeedJobs)
-			}
-		}()
+	if *testSources {
+		if err := runSourceSelfTest(ctx, *reportDir); err != nil {
+			fmt.Printf("Error writing source report: %v\n", err)
+			os.Exit(1)
+		}
+		return
 	}
 
-	// LinkedIn
-	if cfg.Sources["linkedin"] {
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			if linkedinJobs, err := fetchLinkedInJobs(); err == nil {
-				addJobs("LinkedIn", linkedinJobs)
-			}
-		}()
-	}
+	httpCache = openHTTPCache(cfg.HTTP.Cache)
 
-	// Naukri
-	if cfg.Sources["naukri"] {
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			if naukriJobs, err := fetchNaukriJobs(); err == nil {
-				addJobs("Naukri", naukriJobs)
-			}
-		}()
+	if flag.Arg(0) == "health" {
+		// Reads the history without opening the store, so nothing is written
+		if err := runHealthCommand(cfg.Storage); err != nil {
+			fmt.Printf("Error reading health history: %v\n", err)
+			os.Exit(1)
+		}
+		return
 	}
 
-	// Instahyre
-	if cfg.Sources["instahyre"] {
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			if instahyreJobs, err := fetchInstahyreJobs(); err == nil {
-				addJobs("Instahyre", instahyreJobs)
-			}
-		}()
+	store, err := openStore(cfg.Storage)
+	if err != nil {
+		fmt.Printf("Error opening job store: %v\n", err)
+		os.Exit(1)
 	}
 
-	// Company Career Pages (parallel within)
-	if cfg.Sources["companies"] {
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			fmt.Println("  📋 Scanning company career pages...")
-			if companyJobs, err := fetchAllCompanyJobsParallel(); err == nil {
-				addJobs("Companies", companyJobs)
-			}
-		}()
+	// Always tracked for the anomaly checks; health.enabled only decides
+	// whether open breakers skip sources
+	records, err := store.LoadHealth()
+	if err != nil {
+		fmt.Printf("Warning: could not load source health: %v\n", err)
 	}
+	sourceHealth = newHealthTracker(cfg.Health, records)
 
-	// YCombinator Jobs (workatastartup.com)
-	if cfg.Sources["ycjobs"] {
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			if ycJobs, err := fetchYCJobs(); err == nil {
-				addJobs("YC Jobs", ycJobs)
-			}
-		}()
+	old, err := store.Load()
+	if err != nil {
+		fmt.Printf("Error loading job history: %v\n", err)
+		os.Exit(1)
 	}
+	fmt.Printf("Loaded %d previously seen jobs for deduplication\n", len(old))
 
-	// Hacker News Who's Hiring
-	if cfg.Sources["hnjobs"] {
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			if hnJobs, err := fetchHNJobs(); err == nil {
-				addJobs("HN Jobs", hnJobs)
-			}
-		}()
+	for _, key := range unknownSourceKeys(cfg) {
+		fmt.Printf("Warning: config.yaml enables unknown source %q\n", key)
 	}
 
-	// Reddit (r/cscareerquestions, r/forhire)
-	if cfg.Sources["reddit"] {
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			if redditJobs, err := fetchRedditJobs(); err == nil {
-				addJobs("Reddit", redditJobs)
-			}
-		}()
-	}
+	// Whatever is gathered before the deadline is still filtered, sent and saved
+	runCtx, cancelRun := context.WithTimeout(ctx, time.Duration(cfg.Deadlines.RunMinutes)*time.Minute)
+	defer cancelRun()
 
-	// Triplebyte/Karat
-	if cfg.Sources["triplebyte"] {
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			if tbJobs, err := fetchTriplebyteJobs(); err == nil {
-				addJobs("Triplebyte", tbJobs)
-			}
-		}()
-	}
+	// Fetch from all sources in parallel for speed
+	fmt.Println("🚀 Fetching jobs in parallel...")
+	startTime := time.Now()
 
-	// Shared Lists (Google Sheets / GitHub Tables)
-	if cfg.Sources["sharedlists"] {
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			if listJobs, err := fetchSharedListJobs(); err == nil {
-				addJobs("Shared Lists", listJobs)
-			}
-		}()
+	fetchCtx, cancelFetch := stageContext(runCtx, cfg.Deadlines.FetchSeconds)
+	results := fetchFromSources(fetchCtx, sourceHealth.filterSources(enabledSources(cfg), startTime))
+	fetchTimedOut := fetchCtx.Err() != nil
+	reportStageEnd(fetchCtx, "Fetching")
+	cancelFetch()
+	sourceHealth.recordSourceResults(results, fetchTimedOut, startTime)
+
+	var jobs []Job
+	for _, r := range results {
+		// Sources cut off by the deadline return what they had
+		jobs = append(jobs, r.Jobs...)
+		if r.Err != nil {
+			fmt.Printf("  ✗ %s: %v (%d jobs kept)\n", r.Source.Name(), r.Err, len(r.Jobs))
+			continue
+		}
+		fmt.Printf("  ✓ %s: %d jobs\n", r.Source.Name(), len(r.Jobs))
 	}
 
-	// Wait for all sources
-	wg.Wait()
 	elapsed := time.Since(startTime)
 	fmt.Printf("\n⏱️  Fetched in %.1f seconds\n", elapsed.Seconds())
 
+	if err := httpCache.save(); err != nil {
+		fmt.Printf("Warning: could not save HTTP cache: %v\n", err)
+	}
+
+	// Jobs held back last run because their details failed are tried again
+	var details *detailCache
+	if cfg.Details.Enabled {
+		details = loadDetailCache(cfg.Details.CachePath)
+	}
+
 	// Filter for new eligible jobs (not seen before + matches filters)
 	var newOnes []Job
 	for _, j := range jobs {
-		_, alreadySeen := old[j.ID]
-		if !alreadySeen && isEligibleJob(j) {
+		if (!seenBefore(old, j) || details.pending(j.ID)) && isEligibleJob(j) {
 			newOnes = append(newOnes, j)
 		}
 	}
@@ -377,6 +322,44 @@ func main() {
 	fmt.Printf("\nTotal jobs fetched: %d\n", len(jobs))
 	fmt.Printf("New jobs matching keywords: %d\n", len(newOnes))
 
+	// Descriptions reveal experience requirements the title doesn't, so the
+	// filters run again once they're in
+	if cfg.Details.Enabled && len(newOnes) > 0 {
+		detailsCtx, cancelDetails := stageContext(runCtx, cfg.Deadlines.DetailsSeconds)
+		ready := enrichJobDetails(detailsCtx, newOnes, cfg.Details, details)
+		reportStageEnd(detailsCtx, "Fetching details")
+		cancelDetails()
+		if err := details.save(); err != nil {
+			fmt.Printf("Warning: could not save detail cache: %v\n", err)
+		}
+
+		// Recorded below with the run, so the store keeps the details
+		byID := make(map[string]Job, len(newOnes))
+		for _, j := range newOnes {
+			byID[j.ID] = j
+		}
+		for i := range jobs {
+			if j, ok := byID[jobs[i].ID]; ok {
+				jobs[i] = j
+			}
+		}
+
+		var described []Job
+		for _, j := range ready {
+			if isEligibleJob(j) {
+				described = append(described, j)
+			}
+		}
+		if dropped := len(ready) - len(described); dropped > 0 {
+			fmt.Printf("Dropped %d jobs whose details ruled them out\n", dropped)
+		}
+		newOnes = described
+	}
+
+	if err := store.RecordRun(startTime, jobs); err != nil {
+		fmt.Printf("Warning: could not record run: %v\n", err)
+	}
+
 	var finalJobs []Job
 
 	// AI Matching Pass
@@ -395,6 +378,9 @@ func main() {
 
 			fmt.Printf("⚡ Parallel AI Scoring enabled (Concurrency: %d)\n", concurrency)
 
+			aiCtx, cancelAI := stageContext(runCtx, cfg.Deadlines.AISeconds)
+			defer cancelAI()
+
 			for i, j := range newOnes {
 				wg.Add(1)
 				go func(idx int, job Job) {
@@ -402,8 +388,14 @@ func main() {
 					sem <- struct{}{}        // Acquire semaphore
 					defer func() { <-sem }() // Release
 
-					fmt.Printf("[%d/%d] Scoring: %s...\n", idx+1, len(newOnes), job.Title)
-					score, _, err := scoreJobWithAI(job, cfg.AI)
+					// Out of time: sent unscored, as on an error
+					if aiCtx.Err() != nil {
+						results <- job
+						return
+					}
+
+					fmt.Printf("[%d/%d] Scoring: %s...\n", idx+1, len(newOnes), job.displayTitle())
+					score, reason, err := scoreJobWithAI(aiCtx, job, cfg.AI)
 
 					if err != nil {
 						fmt.Printf("Error scoring %s: %v\n", job.Title, err)
@@ -411,6 +403,10 @@ func main() {
 						return
 					}
 
+					if err := store.SaveScore(job.ID, score, reason, time.Now()); err != nil {
+						fmt.Printf("Warning: could not save score for %s: %v\n", job.Title, err)
+					}
+
 					if score >= cfg.AI.Threshold {
 						// ENRICHMENT STEP: If very high score (e.g. >= 90), try to find recruiters
 						// Just show their LinkedIn profile link, no fancy email guessing (user request)
@@ -429,6 +425,7 @@ func main() {
 			for j := range results {
 				finalJobs = append(finalJobs, j)
 			}
+			reportStageEnd(aiCtx, "AI scoring")
 		}
 	} else {
 		finalJobs = newOnes
@@ -437,22 +434,42 @@ func main() {
 	if len(finalJobs) > 0 {
 		msg := "🚨 New Jobs Found:\n\n"
 		for _, j := range finalJobs {
-			msg += fmt.Sprintf("• %s\n%s\n\n", j.Title, j.Link)
+			msg += fmt.Sprintf("• %s\n%s\n\n", j.displayTitle(), j.Link)
+		}
+		// Sending gets its own budget so a run that hit its deadline still reports
+		notifyCtx, cancelNotify := stageContext(context.WithoutCancel(ctx), cfg.Deadlines.NotifySeconds)
+		err := sendTelegram(notifyCtx, msg)
+		cancelNotify()
+		if err == nil {
+			var ids []string
+			for _, j := range finalJobs {
+				ids = append(ids, j.ID)
+			}
+			if err := store.MarkNotified(ids, time.Now()); err != nil {
+				fmt.Printf("Warning: could not mark jobs as notified: %v\n", err)
+			}
 		}
-		sendTelegram(msg)
 	}
 
-	// Save all jobs with timestamps to track what we've seen
-	saveJobRecords(jobs, old)
-}
-
-func loadAllJobs() []Job {
-	file, err := os.ReadFile("jobs.json")
-	if err != nil {
-		return []Job{}
+	// Scrapers that look broken are reported to the admin, apart from job alerts
+	if report := sourceHealth.anomalyReport(); report != "" {
+		adminCtx, cancelAdmin := stageContext(context.WithoutCancel(ctx), cfg.Deadlines.NotifySeconds)
+		if err := sendAdminTelegram(adminCtx, report); err != nil {
+			fmt.Printf("Warning: could not send source check alert: %v\n", err)
+		}
+		cancelAdmin()
 	}
 
-	var jobs []Job
-	json.Unmarshal(file, &jobs)
-	return jobs
+	// Drop jobs we haven't seen within the retention period, then flush
+	cutoff := time.Now().AddDate(0, 0, -cfg.RetentionDays)
+	if err := store.Prune(cutoff); err != nil {
+		fmt.Printf("Warning: could not prune job history: %v\n", err)
+	}
+	if err := store.SaveHealth(sourceHealth.snapshot(cutoff)); err != nil {
+		fmt.Printf("Warning: could not save source health: %v\n", err)
+	}
+	if err := store.Close(); err != nil {
+		fmt.Printf("Error saving job history: %v\n", err)
+		os.Exit(1)
+	}
 }
@@ -1,17 +1,21 @@
 package main
 
 import (
+	"bytes"
+	"context"
 	"fmt"
-	"io"
-	"net/http"
 	"regexp"
 	"strings"
 
 	"github.com/PuerkitoBio/goquery"
 )
 
+func init() {
+	registerSource(newSource("Naukri", "naukri", fetchNaukriJobs))
+}
+
 // fetchNaukriJobs tries to scrape Naukri - may be limited without JS
-func fetchNaukriJobs() ([]Job, error) {
+func fetchNaukriJobs(ctx context.Context) ([]Job, error) {
 	var allJobs []Job
 
 	searches := []string{
@@ -21,8 +25,11 @@ func fetchNaukriJobs() ([]Job, error) {
 	}
 
 	for _, search := range searches {
+		if ctx.Err() != nil {
+			break
+		}
 		url := fmt.Sprintf("https://www.naukri.com/%s-jobs?experience=0-1&jobAge=1", search)
-		jobs, err := scrapeNaukriPage(url)
+		jobs, err := scrapeNaukriPage(ctx, url)
 		if err != nil {
 			continue
 		}
@@ -46,29 +53,21 @@ func fetchNaukriJobs() ([]Job, error) {
 	return unique, nil
 }
 
-func scrapeNaukriPage(url string) ([]Job, error) {
-	req, _ := http.NewRequest("GET", url, nil)
-	req.Header.Set("User-Agent", "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36")
-	req.Header.Set("Accept", "text/html,application/xhtml+xml")
+// naukriJobIDRegex reads the ID that ends job-listings-...-150125001234?src=...
+var naukriJobIDRegex = regexp.MustCompile(`-(\d+)(?:\?|$)`)
 
-	resp, err := http.DefaultClient.Do(req)
+func scrapeNaukriPage(ctx context.Context, url string) ([]Job, error) {
+	body, err := httpGet(ctx, url, profileBrowser)
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("status %d", resp.StatusCode)
-	}
-
-	body, _ := io.ReadAll(resp.Body)
-	doc, err := goquery.NewDocumentFromReader(strings.NewReader(string(body)))
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
 	if err != nil {
 		return nil, err
 	}
 
 	var jobs []Job
-	jobIDRegex := regexp.MustCompile(`-(\d+)\?`)
 
 	// Try to find job listings
 	doc.Find("a[href*='job-listings']").Each(func(i int, s *goquery.Selection) {
@@ -82,19 +81,17 @@ func scrapeNaukriPage(url string) ([]Job, error) {
 			return
 		}
 
-		var jobID string
-		if matches := jobIDRegex.FindStringSubmatch(link); len(matches) > 1 {
-			jobID = matches[1]
-		} else {
-			jobID = fmt.Sprintf("%d", i)
-		}
-
 		if !strings.HasPrefix(link, "http") {
 			link = "https://www.naukri.com" + link
 		}
 
+		var jobID string
+		if matches := naukriJobIDRegex.FindStringSubmatch(link); len(matches) > 1 {
+			jobID = matches[1]
+		}
+
 		jobs = append(jobs, Job{
-			ID:     "naukri-" + jobID,
+			ID:     makeJobID("naukri", jobID, link),
 			Title:  title,
 			Link:   link,
 			Source: "Naukri",
@@ -0,0 +1,193 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// ================== ORACLE CLOUD HCM ==================
+// Candidate Experience sites ({pod}.fa.oraclecloud.com/hcmUI/CandidateExperience/
+// en/sites/{site}) are backed by the recruitingCEJobRequisitions REST resource.
+
+func init() {
+	registerATS("oraclehcm", fetchOracleHCMJobs)
+	registerATSDetail("oraclehcm", fetchOracleHCMDetail)
+}
+
+const (
+	oracleHCMPageSize = 25
+	oracleHCMMaxPages = 8
+)
+
+type oracleHCMResponse struct {
+	Items []struct {
+		TotalJobsCount  int `json:"TotalJobsCount"`
+		RequisitionList []struct {
+			ID                     string `json:"Id"`
+			Title                  string `json:"Title"`
+			PostedDate             string `json:"PostedDate"` // YYYY-MM-DD
+			PrimaryLocation        string `json:"PrimaryLocation"`
+			PrimaryLocationCountry string `json:"PrimaryLocationCountry"`
+			WorkplaceType          string `json:"WorkplaceType"` // On-site, Hybrid, Remote
+			JobFamily              string `json:"JobFamily"`
+			JobSchedule            string `json:"JobSchedule"` // Full time, Part time
+			ShortDescription       string `json:"ShortDescriptionStr"`
+			SecondaryLocations     []struct {
+				Name string `json:"Name"`
+			} `json:"secondaryLocations"`
+		} `json:"requisitionList"`
+	} `json:"items"`
+}
+
+type oracleHCMDetailResponse struct {
+	Items []struct {
+		ExternalDescription      string `json:"ExternalDescriptionStr"`    // HTML
+		ExternalQualifications   string `json:"ExternalQualificationsStr"` // HTML
+		ExternalResponsibilities string `json:"ExternalResponsibilitiesStr"`
+	} `json:"items"`
+}
+
+var oracleHCMSiteRegex = regexp.MustCompile(`/sites/([A-Za-z0-9_]+)`)
+
+// fetchOracleHCMJobs pages through a Candidate Experience site's requisitions.
+// The site number comes from company.URL (or company.Token); the location and
+// locationId query parameters on the URL narrow the search.
+func fetchOracleHCMJobs(ctx context.Context, company CompanyCareer) ([]Job, error) {
+	u, err := url.Parse(company.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	site := company.Token
+	if site == "" {
+		if m := oracleHCMSiteRegex.FindStringSubmatch(u.Path); m != nil {
+			site = m[1]
+		}
+	}
+	if site == "" {
+		return nil, fmt.Errorf("oraclehcm: no site number in %s", company.URL)
+	}
+
+	query := u.Query()
+	search := query.Get("keyword")
+	if search == "" {
+		search = atsSearch(company)
+	}
+
+	var jobs []Job
+	err = fetchPages(ctx, oracleHCMMaxPages, func(page int) (bool, error) {
+		// The finder is a single parameter of comma-separated key=value pairs
+		finder := []string{
+			"siteNumber=" + site,
+			"facetsList=LOCATIONS;WORK_LOCATIONS;WORKPLACE_TYPES;TITLES;CATEGORIES;ORGANIZATIONS;POSTING_DATES;FLEX_FIELDS",
+			fmt.Sprintf("limit=%d", oracleHCMPageSize),
+			fmt.Sprintf("offset=%d", page*oracleHCMPageSize),
+			fmt.Sprintf("keyword=%q", search),
+			"sortBy=POSTING_DATES_DESC",
+		}
+		if locationID := query.Get("locationId"); locationID != "" {
+			finder = append(finder, "locationId="+locationID)
+		} else if location := query.Get("location"); location != "" {
+			finder = append(finder, fmt.Sprintf("location=%q", location))
+		}
+
+		params := url.Values{}
+		params.Set("onlyData", "true")
+		params.Set("expand", "requisitionList.secondaryLocations")
+		params.Set("finder", "findReqs;"+strings.Join(finder, ","))
+		apiURL := fmt.Sprintf("https://%s/hcmRestApi/resources/latest/recruitingCEJobRequisitions?%s", u.Host, params.Encode())
+
+		var data oracleHCMResponse
+		if err := getJSON(ctx, apiURL, &data); err != nil {
+			return false, err
+		}
+		if len(data.Items) == 0 {
+			return false, nil
+		}
+
+		result := data.Items[0]
+		for _, r := range result.RequisitionList {
+			if !isEntryLevelJob(r.Title) {
+				continue
+			}
+
+			locations := []string{r.PrimaryLocation}
+			for _, l := range r.SecondaryLocations {
+				locations = append(locations, l.Name)
+			}
+			location := joinNonEmpty("; ", locations...)
+
+			workMode := detectWorkMode(r.WorkplaceType)
+			if workMode == "" {
+				workMode = detectWorkMode(location)
+			}
+
+			var tags []string
+			if r.JobFamily != "" {
+				tags = append(tags, r.JobFamily)
+			}
+
+			date, _ := time.Parse("2006-01-02", r.PostedDate)
+
+			link := fmt.Sprintf("https://%s/hcmUI/CandidateExperience/en/sites/%s/job/%s", u.Host, site, r.ID)
+			jobs = append(jobs, Job{
+				ID:             makeJobID(companySlug(company.Name), r.ID, link),
+				Title:          r.Title,
+				Link:           link,
+				Source:         company.Name,
+				Company:        company.Name,
+				Location:       location,
+				WorkMode:       workMode,
+				EmploymentType: r.JobSchedule,
+				Description:    htmlToText(r.ShortDescription),
+				Tags:           tags,
+				Date:           date,
+			})
+		}
+
+		return len(result.RequisitionList) == oracleHCMPageSize && (page+1)*oracleHCMPageSize < result.TotalJobsCount, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("oraclehcm %s: %w", u.Host, err)
+	}
+
+	return jobs, nil
+}
+
+// fetchOracleHCMDetail reads one requisition's description
+func fetchOracleHCMDetail(ctx context.Context, company CompanyCareer, job Job) (Job, error) {
+	u, err := url.Parse(company.URL)
+	if err != nil {
+		return Job{}, err
+	}
+	site := company.Token
+	if m := oracleHCMSiteRegex.FindStringSubmatch(u.Path); site == "" && m != nil {
+		site = m[1]
+	}
+
+	params := url.Values{}
+	params.Set("onlyData", "true")
+	params.Set("expand", "all")
+	params.Set("finder", fmt.Sprintf("ById;Id=%q,siteNumber=%s", atsJobID(company, job), site))
+	apiURL := fmt.Sprintf("https://%s/hcmRestApi/resources/latest/recruitingCEJobRequisitionDetails?%s", u.Host, params.Encode())
+
+	var data oracleHCMDetailResponse
+	if err := getJSON(ctx, apiURL, &data); err != nil {
+		return Job{}, fmt.Errorf("oraclehcm %s: %w", u.Host, err)
+	}
+	if len(data.Items) == 0 {
+		return Job{}, fmt.Errorf("oraclehcm %s: requisition not found", u.Host)
+	}
+
+	item := data.Items[0]
+	return Job{
+		Description: joinNonEmpty("\n\n",
+			htmlToText(item.ExternalDescription),
+			htmlToText(item.ExternalResponsibilities),
+			htmlToText(item.ExternalQualifications)),
+	}, nil
+}
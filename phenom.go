@@ -0,0 +1,283 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// ================== PHENOM ==================
+// Phenom People sites (careers.{company}.com/{country}/{lang}/search-results)
+// embed the first page of results as JSON in a `phApp.ddo = {...}` script.
+// Further pages come from POST /widgets with the same search.
+
+func init() {
+	registerATS("phenom", fetchPhenomJobs)
+}
+
+const (
+	phenomPageSize = 10 // What the sites themselves request
+	phenomMaxPages = 10
+)
+
+// phenomSearch is the refineSearch block, both in phApp.ddo and from /widgets
+type phenomSearch struct {
+	TotalHits int `json:"totalHits"`
+	Data      struct {
+		Jobs []phenomJob `json:"jobs"`
+	} `json:"data"`
+}
+
+type phenomJob struct {
+	ReqID             string   `json:"reqId"`
+	JobID             string   `json:"jobId"`
+	JobSeqNo          string   `json:"jobSeqNo"`
+	Title             string   `json:"title"`
+	City              string   `json:"city"`
+	State             string   `json:"state"`
+	Country           string   `json:"country"`
+	Location          string   `json:"location"`
+	MultiLocation     []string `json:"multi_location"`
+	PostedDate        string   `json:"postedDate"`
+	Category          string   `json:"category"`
+	Type              string   `json:"type"`
+	DescriptionTeaser string   `json:"descriptionTeaser"`
+	ApplyURL          string   `json:"applyUrl"`
+	ExperienceLevel   string   `json:"experienceLevel"`
+}
+
+type phenomWidgetRequest struct {
+	Lang           string              `json:"lang"`
+	DeviceType     string              `json:"deviceType"`
+	Country        string              `json:"country"`
+	PageName       string              `json:"pageName"`
+	DDOKey         string              `json:"ddoKey"`
+	From           int                 `json:"from"`
+	Size           int                 `json:"size"`
+	Jobs           bool                `json:"jobs"`
+	Counts         bool                `json:"counts"`
+	Keywords       string              `json:"keywords"`
+	Global         bool                `json:"global"`
+	SelectedFields map[string][]string `json:"selected_fields"`
+	RefNum         string              `json:"refNum"`
+}
+
+var (
+	phenomDDORegex    = regexp.MustCompile(`phApp\.ddo\s*=\s*`)
+	phenomRefNumRegex = regexp.MustCompile(`"refNum"\s*:\s*"([A-Za-z0-9]+)"`)
+	phenomCSRFRegex   = regexp.MustCompile(`"csrfToken"\s*:\s*"([^"]+)"`)
+	phenomLocaleRegex = regexp.MustCompile(`^/([a-z]+)/([a-z]{2})/`)
+)
+
+// fetchPhenomJobs reads the embedded first page of a search-results URL, then
+// pages through /widgets. ?keywords= and ?location= (a country) on company.URL
+// become the search.
+func fetchPhenomJobs(ctx context.Context, company CompanyCareer) ([]Job, error) {
+	u, err := url.Parse(company.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	idx := strings.Index(u.Path, "/search-results")
+	if idx < 0 {
+		return nil, fmt.Errorf("phenom: no /search-results path in %s", company.URL)
+	}
+	base := fmt.Sprintf("https://%s%s", u.Host, u.Path[:idx]) // e.g. https://careers.adobe.com/us/en
+
+	// The sessions cookie from the page is needed for /widgets
+	session := fetcher.Session()
+
+	page, err := phenomGet(ctx, session, company.URL)
+	if err != nil {
+		return nil, fmt.Errorf("phenom %s: %w", u.Host, err)
+	}
+
+	first, err := parsePhenomDDO(page)
+	if err != nil {
+		return nil, fmt.Errorf("phenom %s: %w", u.Host, err)
+	}
+
+	var jobs []Job
+	seen := map[string]bool{}
+	addJobs := func(results []phenomJob) {
+		for _, p := range results {
+			job, ok := phenomToJob(company, base, p)
+			if !ok || seen[job.ID] {
+				continue
+			}
+			seen[job.ID] = true
+			jobs = append(jobs, job)
+		}
+	}
+
+	refNum := ""
+	if m := phenomRefNumRegex.FindSubmatch(page); m != nil {
+		refNum = string(m[1])
+	}
+
+	csrf := ""
+	if m := phenomCSRFRegex.FindSubmatch(page); m != nil {
+		csrf = string(m[1])
+	}
+
+	query := u.Query()
+	keywords := query.Get("keywords")
+	if keywords == "" {
+		keywords = atsSearch(company)
+	}
+	selected := map[string][]string{}
+	if location := query.Get("location"); location != "" {
+		selected["country"] = []string{location}
+	}
+
+	lang, country := "en_us", "us"
+	if m := phenomLocaleRegex.FindStringSubmatch(u.Path); m != nil {
+		lang, country = m[2]+"_"+m[1], m[1]
+	}
+
+	// Page 0 is the search page itself; later pages come from the widgets API
+	fetched := len(first.Data.Jobs)
+	fetchPages(ctx, phenomMaxPages, func(page int) (bool, error) {
+		if page == 0 {
+			addJobs(first.Data.Jobs)
+			return refNum != "" && fetched < first.TotalHits, nil
+		}
+
+		req := phenomWidgetRequest{
+			Lang:           lang,
+			DeviceType:     "desktop",
+			Country:        country,
+			PageName:       "search-results",
+			DDOKey:         "refineSearch",
+			From:           fetched,
+			Size:           phenomPageSize,
+			Jobs:           true,
+			Counts:         true,
+			Keywords:       keywords,
+			Global:         true,
+			SelectedFields: selected,
+			RefNum:         refNum,
+		}
+
+		var data struct {
+			RefineSearch phenomSearch `json:"refineSearch"`
+		}
+		if err := phenomPost(ctx, session, fmt.Sprintf("https://%s/widgets", u.Host), csrf, req, &data); err != nil {
+			return false, err
+		}
+		addJobs(data.RefineSearch.Data.Jobs)
+		fetched += len(data.RefineSearch.Data.Jobs)
+		return len(data.RefineSearch.Data.Jobs) > 0 && fetched < first.TotalHits, nil
+	})
+
+	return jobs, nil
+}
+
+// parsePhenomDDO decodes the object assigned to phApp.ddo and returns its
+// search results (eagerLoadRefineSearch on most sites, refineSearch on some)
+func parsePhenomDDO(page []byte) (phenomSearch, error) {
+	loc := phenomDDORegex.FindIndex(page)
+	if loc == nil {
+		return phenomSearch{}, fmt.Errorf("no phApp.ddo in page")
+	}
+
+	// The decoder stops at the end of the object, ignoring the script after it
+	var ddo struct {
+		EagerLoadRefineSearch *phenomSearch `json:"eagerLoadRefineSearch"`
+		RefineSearch          *phenomSearch `json:"refineSearch"`
+	}
+	if err := json.NewDecoder(bytes.NewReader(page[loc[1]:])).Decode(&ddo); err != nil {
+		return phenomSearch{}, fmt.Errorf("phApp.ddo: %w", err)
+	}
+
+	switch {
+	case ddo.EagerLoadRefineSearch != nil:
+		return *ddo.EagerLoadRefineSearch, nil
+	case ddo.RefineSearch != nil:
+		return *ddo.RefineSearch, nil
+	}
+	return phenomSearch{}, fmt.Errorf("phApp.ddo has no search results")
+}
+
+func phenomToJob(company CompanyCareer, base string, p phenomJob) (Job, bool) {
+	if !isEntryLevelJob(p.Title + " " + p.ExperienceLevel) {
+		return Job{}, false
+	}
+
+	id := p.JobID
+	if id == "" {
+		id = p.ReqID
+	}
+	if id == "" {
+		return Job{}, false
+	}
+
+	location := p.Location
+	if len(p.MultiLocation) > 1 {
+		location = strings.Join(p.MultiLocation, "; ")
+	}
+	if location == "" {
+		location = joinNonEmpty(", ", p.City, p.State, p.Country)
+	}
+
+	var tags []string
+	if p.Category != "" {
+		tags = append(tags, p.Category)
+	}
+
+	date, _ := time.Parse("2006-01-02T15:04:05.000-0700", p.PostedDate)
+	if date.IsZero() {
+		date, _ = time.Parse("2006-01-02", strings.SplitN(p.PostedDate, "T", 2)[0])
+	}
+
+	link := fmt.Sprintf("%s/job/%s", base, url.PathEscape(id))
+	return Job{
+		ID:             makeJobID(companySlug(company.Name), id, link),
+		Title:          p.Title,
+		Link:           link,
+		Source:         company.Name,
+		Company:        company.Name,
+		Location:       location,
+		WorkMode:       detectWorkMode(location + " " + p.Title),
+		EmploymentType: p.Type,
+		Description:    strings.TrimSpace(p.DescriptionTeaser),
+		Tags:           tags,
+		Date:           date,
+	}, true
+}
+
+func phenomGet(ctx context.Context, session *FetchSession, pageURL string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", pageURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	return readResponse(session.Do(req, profileBrowser))
+}
+
+func phenomPost(ctx context.Context, session *FetchSession, apiURL, csrf string, body, v interface{}) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", apiURL, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if csrf != "" {
+		req.Header.Set("x-csrf-token", csrf)
+	}
+
+	data, err := readResponse(session.Do(req, profileJSON))
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
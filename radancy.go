@@ -0,0 +1,194 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// ================== RADANCY ==================
+// Radancy (formerly TMP Worldwide) career sites serve /search-jobs/{Location}/
+// with only the first page rendered. Further pages come from the AJAX endpoint
+// /search-jobs/results, which returns the result list as an HTML fragment.
+
+func init() {
+	registerATS("radancy", fetchRadancyJobs)
+}
+
+const (
+	radancyPageSize = 50
+	radancyMaxPages = 6
+)
+
+type radancyResponse struct {
+	Results string `json:"results"` // HTML fragment
+}
+
+// fetchRadancyJobs pages through a Radancy site's search results. The location
+// is the path segment after /search-jobs/ in company.URL (e.g. "India"), or its
+// ?location= parameter.
+func fetchRadancyJobs(ctx context.Context, company CompanyCareer) ([]Job, error) {
+	u, err := url.Parse(company.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	idx := strings.Index(u.Path, "/search-jobs")
+	if idx < 0 {
+		return nil, fmt.Errorf("radancy: no /search-jobs path in %s", company.URL)
+	}
+	base := fmt.Sprintf("https://%s%s", u.Host, u.Path[:idx]) // keeps locale prefixes like /en or /in/en
+
+	location := u.Query().Get("location")
+	if rest := strings.Trim(u.Path[idx+len("/search-jobs"):], "/"); location == "" && rest != "" {
+		location = strings.Split(rest, "/")[0]
+	}
+
+	var jobs []Job
+	seen := map[string]bool{}
+
+	err = fetchPages(ctx, radancyMaxPages, func(page int) (bool, error) {
+		params := url.Values{}
+		params.Set("ActiveFacetID", "0")
+		params.Set("CurrentPage", strconv.Itoa(page+1)) // 1-based
+		params.Set("RecordsPerPage", strconv.Itoa(radancyPageSize))
+		params.Set("Distance", "50")
+		params.Set("RadiusUnitType", "0")
+		params.Set("Keywords", atsSearch(company))
+		params.Set("Location", location)
+		params.Set("ShowRadius", "False")
+		params.Set("IsPagination", "True")
+		params.Set("FacetType", "0")
+		params.Set("SearchResultsModuleName", "Search Results")
+		params.Set("SearchFiltersModuleName", "Search Filters")
+		params.Set("SortCriteria", "0")
+		params.Set("SortDirection", "0")
+		params.Set("SearchType", "5")
+		apiURL := base + "/search-jobs/results?" + params.Encode()
+
+		doc, totalPages, err := fetchRadancyPage(ctx, apiURL)
+		if err != nil {
+			return false, err
+		}
+
+		found := 0
+		doc.Find("a[data-job-id]").Each(func(i int, s *goquery.Selection) {
+			id, _ := s.Attr("data-job-id")
+			href, _ := s.Attr("href")
+			if id == "" || href == "" || seen[id] {
+				return
+			}
+			seen[id] = true
+			found++
+
+			title := strings.TrimSpace(s.Find("h2, h3, .job-title").First().Text())
+			if title == "" {
+				title = strings.TrimSpace(s.Text())
+			}
+
+			if !isEntryLevelJob(title) {
+				return
+			}
+
+			link := href
+			if ref, err := url.Parse(href); err == nil {
+				link = u.ResolveReference(ref).String()
+			}
+
+			jobLocation := strings.TrimSpace(s.Find(".job-location").First().Text())
+			posted := strings.TrimSpace(s.Find(".job-date-posted").First().Text())
+
+			jobs = append(jobs, Job{
+				ID:       makeJobID(companySlug(company.Name), id, link),
+				Title:    title,
+				Link:     link,
+				Source:   company.Name,
+				Company:  company.Name,
+				Location: jobLocation,
+				WorkMode: detectWorkMode(jobLocation + " " + title),
+				Date:     parseRadancyDate(posted, company.Country),
+			})
+		})
+
+		return found > 0 && page+1 < totalPages, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("radancy %s: %w", u.Host, err)
+	}
+
+	return jobs, nil
+}
+
+// fetchRadancyPage calls the results endpoint and parses the HTML fragment.
+// totalPages comes from the data-total-pages attribute (1 if absent).
+func fetchRadancyPage(ctx context.Context, apiURL string) (*goquery.Document, int, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", apiURL, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	req.Header.Set("X-Requested-With", "XMLHttpRequest") // Without it the site returns the full page
+
+	body, err := readResponse(fetcher.Do(req, profileJSON))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var data radancyResponse
+	if err := json.Unmarshal(body, &data); err != nil {
+		return nil, 0, err
+	}
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(data.Results))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	totalPages := 1
+	if v, ok := doc.Find("[data-total-pages]").First().Attr("data-total-pages"); ok {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			totalPages = n
+		}
+	}
+	return doc, totalPages, nil
+}
+
+// radancyMonthFirst lists the countries whose sites write numeric dates as
+// MM/DD/YYYY; everywhere else uses DD/MM/YYYY
+var radancyMonthFirst = map[string]bool{"US": true}
+
+// parseRadancyDate reads the posted date, which sites render as "Jan. 15, 2024"
+// or as a numeric date in their locale's order. The order is taken from the
+// company's country; without one, a date like 03/04/2025 that reads both ways
+// is left unset rather than guessed.
+func parseRadancyDate(s, country string) time.Time {
+	s = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(s), "Date Posted:"))
+	for _, layout := range []string{"Jan. 2, 2006", "Jan 2, 2006", "2006-01-02", "02.01.2006"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t
+		}
+	}
+
+	monthFirst, monthErr := time.Parse("01/02/2006", s)
+	dayFirst, dayErr := time.Parse("02/01/2006", s)
+	switch {
+	case monthErr != nil && dayErr != nil:
+		return time.Time{}
+	case dayErr != nil, monthFirst.Equal(dayFirst):
+		return monthFirst
+	case monthErr != nil:
+		return dayFirst
+	case country == "":
+		return time.Time{}
+	case radancyMonthFirst[strings.ToUpper(country)]:
+		return monthFirst
+	default:
+		return dayFirst
+	}
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseRadancyDate(t *testing.T) {
+	tests := []struct {
+		s, country string
+		want       time.Time
+	}{
+		{"03/04/2025", "US", time.Date(2025, 3, 4, 0, 0, 0, 0, time.UTC)},
+		{"03/04/2025", "IN", time.Date(2025, 4, 3, 0, 0, 0, 0, time.UTC)},
+		{"03/04/2025", "gb", time.Date(2025, 4, 3, 0, 0, 0, 0, time.UTC)},
+		{"03/04/2025", "", time.Time{}},
+		{"04/04/2025", "", time.Date(2025, 4, 4, 0, 0, 0, 0, time.UTC)},
+		{"25/04/2025", "US", time.Date(2025, 4, 25, 0, 0, 0, 0, time.UTC)},
+		{"04/25/2025", "IN", time.Date(2025, 4, 25, 0, 0, 0, 0, time.UTC)},
+		{"Date Posted: Jan. 15, 2024", "", time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)},
+		{"15.01.2024", "DE", time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)},
+		{"recently", "US", time.Time{}},
+	}
+
+	for _, tt := range tests {
+		if got := parseRadancyDate(tt.s, tt.country); !got.Equal(tt.want) {
+			t.Errorf("parseRadancyDate(%q, %q) = %v, want %v", tt.s, tt.country, got, tt.want)
+		}
+	}
+}
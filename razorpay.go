@@ -1,26 +1,24 @@
 package main
 
 import (
-	"io"
-	"net/http"
+	"bytes"
+	"context"
 	"strings"
 
 	"github.com/PuerkitoBio/goquery"
 )
 
-func fetchRazorpayJobs() ([]Job, error) {
-	req, _ := http.NewRequest("GET", "https://razorpay.com/jobs/", nil)
-	req.Header.Set("User-Agent", "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36")
-	req.Header.Set("Accept", "text/html,application/xhtml+xml")
+func init() {
+	registerSource(newSource("Razorpay", "razorpay", fetchRazorpayJobs))
+}
 
-	resp, err := http.DefaultClient.Do(req)
+func fetchRazorpayJobs(ctx context.Context) ([]Job, error) {
+	body, err := httpGet(ctx, "https://razorpay.com/jobs/", profileBrowser)
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
 
-	body, _ := io.ReadAll(resp.Body)
-	doc, err := goquery.NewDocumentFromReader(strings.NewReader(string(body)))
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
 	if err != nil {
 		return nil, err
 	}
@@ -65,7 +63,7 @@ func fetchRazorpayJobs() ([]Job, error) {
 				}
 
 				jobs = append(jobs, Job{
-					ID:     "razorpay-" + link,
+					ID:     makeJobID("razorpay", "", link),
 					Title:  title,
 					Link:   link,
 					Source: "Razorpay",
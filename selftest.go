@@ -0,0 +1,192 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// SourceTestResult is the outcome of probing one source or company page in isolation
+type SourceTestResult struct {
+	Name       string `json:"name"`
+	Kind       string `json:"kind"` // "source" or "company"
+	Key        string `json:"key,omitempty"`
+	URL        string `json:"url,omitempty"`
+	Enabled    bool   `json:"enabled"`
+	Jobs       int    `json:"jobs"`
+	HTTPStatus int    `json:"http_status,omitempty"`
+	LatencyMs  int64  `json:"latency_ms"`
+	Error      string `json:"error,omitempty"`
+}
+
+// SourceTestReport is written as JSON and Markdown by --test-sources
+type SourceTestReport struct {
+	GeneratedAt time.Time          `json:"generated_at"`
+	DurationSec float64            `json:"duration_sec"`
+	Results     []SourceTestResult `json:"results"`
+}
+
+// runSourceSelfTest probes every registered source and every company career page
+// without notifying or touching jobs.json, then writes the report to reportDir
+func runSourceSelfTest(ctx context.Context, reportDir string) error {
+	start := time.Now()
+
+	report := SourceTestReport{GeneratedAt: start}
+
+	fmt.Println("🧪 Testing sources one at a time...")
+	for _, s := range registeredSources() {
+		// Company pages are probed individually below
+		if s.ConfigKey() == "companies" {
+			continue
+		}
+
+		// All scrapers go through the shared fetcher, which notes each
+		// response's status in the request's trace
+		traced, trace := withStatusTrace(ctx)
+		t0 := time.Now()
+		jobs, err := s.Fetch(traced)
+
+		result := SourceTestResult{
+			Name:       s.Name(),
+			Kind:       "source",
+			Key:        s.ConfigKey(),
+			Enabled:    cfg.Sources[s.ConfigKey()],
+			Jobs:       len(jobs),
+			HTTPStatus: trace.last(),
+			LatencyMs:  time.Since(t0).Milliseconds(),
+		}
+		if err != nil {
+			result.Error = err.Error()
+		}
+		fmt.Printf("  %s %s: %d jobs (HTTP %d, %dms)\n", resultIcon(result), result.Name, result.Jobs, result.HTTPStatus, result.LatencyMs)
+		report.Results = append(report.Results, result)
+	}
+
+	fmt.Printf("🧪 Testing %d company career pages...\n", len(companyCareerPages))
+	companyResults := make([]SourceTestResult, len(companyCareerPages))
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, 5)
+
+	for i, company := range companyCareerPages {
+		wg.Add(1)
+		go func(idx int, c CompanyCareer) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			// ATS-backed companies are fetched from their API, not c.URL
+			traced, trace := withStatusTrace(ctx)
+			t0 := time.Now()
+			jobs, err := fetchCompanyJobs(traced, c)
+
+			result := SourceTestResult{
+				Name:       c.Name,
+				Kind:       "company",
+				URL:        c.URL,
+				Enabled:    cfg.Sources["companies"],
+				Jobs:       len(jobs),
+				HTTPStatus: trace.last(),
+				LatencyMs:  time.Since(t0).Milliseconds(),
+			}
+			if err != nil {
+				result.Error = err.Error()
+			}
+			companyResults[idx] = result
+		}(i, company)
+	}
+	wg.Wait()
+
+	report.Results = append(report.Results, companyResults...)
+	report.DurationSec = time.Since(start).Seconds()
+
+	return writeSourceTestReport(report, reportDir)
+}
+
+func resultIcon(r SourceTestResult) string {
+	switch {
+	case r.Error != "" || r.HTTPStatus >= 400:
+		return "❌"
+	case r.Jobs == 0:
+		return "⚠️"
+	default:
+		return "✅"
+	}
+}
+
+func writeSourceTestReport(report SourceTestReport, reportDir string) error {
+	if err := os.MkdirAll(reportDir, 0755); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	jsonPath := filepath.Join(reportDir, "source-report.json")
+	if err := os.WriteFile(jsonPath, data, 0644); err != nil {
+		return err
+	}
+
+	mdPath := filepath.Join(reportDir, "source-report.md")
+	if err := os.WriteFile(mdPath, []byte(renderSourceTestMarkdown(report)), 0644); err != nil {
+		return err
+	}
+
+	fmt.Printf("\n📄 Report written to %s and %s\n", mdPath, jsonPath)
+	return nil
+}
+
+func renderSourceTestMarkdown(report SourceTestReport) string {
+	var working, empty, failed int
+	for _, r := range report.Results {
+		switch resultIcon(r) {
+		case "✅":
+			working++
+		case "⚠️":
+			empty++
+		default:
+			failed++
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString("# Source Status Report\n\n")
+	fmt.Fprintf(&b, "Generated %s in %.1f seconds.\n\n", report.GeneratedAt.UTC().Format("2006-01-02 15:04 UTC"), report.DurationSec)
+	fmt.Fprintf(&b, "**%d working, %d returned 0 jobs, %d failed.**\n\n", working, empty, failed)
+
+	writeTable := func(kind, heading string) {
+		fmt.Fprintf(&b, "## %s\n\n", heading)
+		b.WriteString("| Name | Status | Jobs | HTTP | Latency | Enabled | Error |\n")
+		b.WriteString("|---|---|---|---|---|---|---|\n")
+		for _, r := range report.Results {
+			if r.Kind != kind {
+				continue
+			}
+			name := r.Name
+			if r.URL != "" {
+				name = fmt.Sprintf("[%s](%s)", r.Name, r.URL)
+			}
+			status := "-"
+			if r.HTTPStatus > 0 {
+				status = fmt.Sprintf("%d", r.HTTPStatus)
+			}
+			enabled := "no"
+			if r.Enabled {
+				enabled = "yes"
+			}
+			errText := strings.ReplaceAll(r.Error, "|", "\\|")
+			fmt.Fprintf(&b, "| %s | %s | %d | %s | %dms | %s | %s |\n",
+				name, resultIcon(r), r.Jobs, status, r.LatencyMs, enabled, errText)
+		}
+		b.WriteString("\n")
+	}
+
+	writeTable("source", "Sources")
+	writeTable("company", "Company Career Pages")
+	return b.String()
+}
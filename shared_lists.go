@@ -1,21 +1,32 @@
 package main
 
 import (
+	"bytes"
+	"context"
 	"crypto/sha256"
 	"encoding/csv"
 	"encoding/hex"
 	"fmt"
-	"net/http"
 	"strings"
 
 	"github.com/PuerkitoBio/goquery"
 )
 
+func init() {
+	registerSource(newSource("Shared Lists", "sharedlists", fetchSharedListJobs))
+}
+
 func generateStableHash(s string) string {
 	hash := sha256.Sum256([]byte(s))
 	return hex.EncodeToString(hash[:])[:12]
 }
 
+// listRowID identifies a row of a shared list. Many rows link to the same
+// careers page, so the link alone would merge different roles.
+func listRowID(company, role, link string) string {
+	return generateStableHash(company + role + link)
+}
+
 // ListSource represents a public list (Google Sheet or GitHub README)
 type ListSource struct {
 	Name string
@@ -38,17 +49,20 @@ var publicLists = []ListSource{
 	// Add your Google Sheets URLs here in config
 }
 
-func fetchSharedListJobs() ([]Job, error) {
+func fetchSharedListJobs(ctx context.Context) ([]Job, error) {
 	var allJobs []Job
 
 	for _, source := range publicLists {
+		if ctx.Err() != nil {
+			break
+		}
 		var jobs []Job
 		var err error
 
 		if source.Type == "googlesheet" {
-			jobs, err = scrapeGoogleSheet(source.URL)
+			jobs, err = scrapeGoogleSheet(ctx, source.URL)
 		} else if source.Type == "github" {
-			jobs, err = scrapeGitHubReadme(source.URL)
+			jobs, err = scrapeGitHubReadme(ctx, source.URL)
 		}
 
 		if err == nil {
@@ -62,7 +76,7 @@ func fetchSharedListJobs() ([]Job, error) {
 }
 
 // scrapeGoogleSheet downloads a public Google Sheet as CSV and identifies job rows
-func scrapeGoogleSheet(sheetURL string) ([]Job, error) {
+func scrapeGoogleSheet(ctx context.Context, sheetURL string) ([]Job, error) {
 	// Convert /edit URL to /export?format=csv
 	csvURL := sheetURL
 	if strings.Contains(sheetURL, "/edit") {
@@ -70,17 +84,12 @@ func scrapeGoogleSheet(sheetURL string) ([]Job, error) {
 		csvURL = parts[0] + "/export?format=csv"
 	}
 
-	resp, err := http.Get(csvURL)
+	body, err := httpGet(ctx, csvURL, profileBrowser)
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("status %d", resp.StatusCode)
-	}
 
-	reader := csv.NewReader(resp.Body)
+	reader := csv.NewReader(bytes.NewReader(body))
 	rows, err := reader.ReadAll()
 	if err != nil {
 		return nil, err
@@ -139,13 +148,8 @@ func scrapeGoogleSheet(sheetURL string) ([]Job, error) {
 			role = "Software Engineer"
 		}
 
-		title := fmt.Sprintf("%s @ %s", role, company)
-		if location != "" {
-			title += fmt.Sprintf(" (%s)", location)
-		}
-
 		// Experience filter
-		if !isEntryLevelJob(title) {
+		if !isEntryLevelJob(role) {
 			continue
 		}
 
@@ -160,10 +164,13 @@ func scrapeGoogleSheet(sheetURL string) ([]Job, error) {
 		}
 
 		jobs = append(jobs, Job{
-			ID:     fmt.Sprintf("sheet-%s", generateStableHash(company+role+link)),
-			Title:  title,
-			Link:   link,
-			Source: "Shared List",
+			ID:       makeJobID("sheet", listRowID(company, role, link), link),
+			Title:    role,
+			Link:     link,
+			Source:   "Shared List",
+			Company:  company,
+			Location: location,
+			WorkMode: detectWorkMode(location),
 		})
 	}
 
@@ -171,18 +178,13 @@ func scrapeGoogleSheet(sheetURL string) ([]Job, error) {
 }
 
 // scrapeGitHubReadme looks for markdown tables in GitHub READMEs
-func scrapeGitHubReadme(repoURL string) ([]Job, error) {
-	resp, err := http.Get(repoURL)
+func scrapeGitHubReadme(ctx context.Context, repoURL string) ([]Job, error) {
+	body, err := httpGet(ctx, repoURL, profileBrowser)
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("status %d", resp.StatusCode)
-	}
 
-	doc, err := goquery.NewDocumentFromReader(resp.Body)
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
 	if err != nil {
 		return nil, err
 	}
@@ -248,18 +250,25 @@ func scrapeGitHubReadme(repoURL string) ([]Job, error) {
 			role = "Software Engineer"
 		}
 
-		title := fmt.Sprintf("%s @ %s", role, company)
-
 		// Filter
-		if !isEntryLevelJob(title) {
+		if !isEntryLevelJob(role) {
 			return
 		}
 
+		// Third column is the location in the usual layout
+		location := strings.TrimSpace(cols.Eq(2).Text())
+		if strings.HasPrefix(location, "http") || len(location) > 100 {
+			location = ""
+		}
+
 		jobs = append(jobs, Job{
-			ID:     fmt.Sprintf("github-list-%s", generateStableHash(company+role+link)),
-			Title:  title,
-			Link:   link,
-			Source: "GitHub List",
+			ID:       makeJobID("github-list", listRowID(company, role, link), link),
+			Title:    role,
+			Link:     link,
+			Source:   "GitHub List",
+			Company:  company,
+			Location: location,
+			WorkMode: detectWorkMode(location),
 		})
 	})
 
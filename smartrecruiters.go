@@ -0,0 +1,150 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// ================== SMARTRECRUITERS ==================
+// Public posting API: api.smartrecruiters.com/v1/companies/{id}/postings
+
+func init() {
+	registerATS("smartrecruiters", fetchSmartRecruitersJobs)
+	registerATSDetail("smartrecruiters", fetchSmartRecruitersDetail)
+}
+
+const (
+	smartRecruitersPageSize = 100 // API maximum
+	smartRecruitersMaxPages = 5
+)
+
+type smartRecruitersResponse struct {
+	TotalFound int `json:"totalFound"`
+	Content    []struct {
+		ID           string `json:"id"`
+		Name         string `json:"name"`
+		ReleasedDate string `json:"releasedDate"`
+		Location     struct {
+			City         string `json:"city"`
+			Region       string `json:"region"`
+			Country      string `json:"country"`
+			FullLocation string `json:"fullLocation"`
+			Remote       bool   `json:"remote"`
+			Hybrid       bool   `json:"hybrid"`
+		} `json:"location"`
+		TypeOfEmployment struct {
+			Label string `json:"label"`
+		} `json:"typeOfEmployment"`
+		ExperienceLevel struct {
+			Label string `json:"label"`
+		} `json:"experienceLevel"`
+		Department struct {
+			Label string `json:"label"`
+		} `json:"department"`
+	} `json:"content"`
+}
+
+type smartRecruitersPosting struct {
+	JobAd struct {
+		Sections map[string]struct {
+			Title string `json:"title"`
+			Text  string `json:"text"` // HTML
+		} `json:"sections"`
+	} `json:"jobAd"`
+}
+
+// fetchSmartRecruitersDetail reads one posting's job ad sections
+func fetchSmartRecruitersDetail(ctx context.Context, company CompanyCareer, job Job) (Job, error) {
+	apiURL := fmt.Sprintf("https://api.smartrecruiters.com/v1/companies/%s/postings/%s", company.Token, atsJobID(company, job))
+
+	var data smartRecruitersPosting
+	if err := getJSON(ctx, apiURL, &data); err != nil {
+		return Job{}, fmt.Errorf("smartrecruiters %s: %w", company.Token, err)
+	}
+
+	// Sections are keyed companyDescription, jobDescription, qualifications, additionalInformation
+	var parts []string
+	for _, key := range []string{"jobDescription", "qualifications", "additionalInformation"} {
+		if section, ok := data.JobAd.Sections[key]; ok {
+			parts = append(parts, htmlToText(section.Text))
+		}
+	}
+	return Job{Description: joinNonEmpty("\n\n", parts...)}, nil
+}
+
+// fetchSmartRecruitersJobs pages through a company's public postings.
+// company.Token is the company identifier, e.g. "Visa" for jobs.smartrecruiters.com/Visa.
+func fetchSmartRecruitersJobs(ctx context.Context, company CompanyCareer) ([]Job, error) {
+	if company.Token == "" {
+		return nil, fmt.Errorf("smartrecruiters: no company identifier for %s", company.Name)
+	}
+
+	var jobs []Job
+	err := fetchPages(ctx, smartRecruitersMaxPages, func(page int) (bool, error) {
+		params := url.Values{}
+		params.Set("limit", fmt.Sprintf("%d", smartRecruitersPageSize))
+		params.Set("offset", fmt.Sprintf("%d", page*smartRecruitersPageSize))
+		if company.Search != "" {
+			params.Set("q", company.Search)
+		}
+		apiURL := fmt.Sprintf("https://api.smartrecruiters.com/v1/companies/%s/postings?%s", company.Token, params.Encode())
+
+		var data smartRecruitersResponse
+		if err := getJSON(ctx, apiURL, &data); err != nil {
+			return false, err
+		}
+
+		for _, p := range data.Content {
+			if !isEntryLevelJob(p.Name + " " + p.ExperienceLevel.Label) {
+				continue
+			}
+
+			location := p.Location.FullLocation
+			if location == "" {
+				location = joinNonEmpty(", ", p.Location.City, p.Location.Region, strings.ToUpper(p.Location.Country))
+			}
+
+			workMode := detectWorkMode(location)
+			switch {
+			case p.Location.Remote:
+				workMode = WorkModeRemote
+			case p.Location.Hybrid:
+				workMode = WorkModeHybrid
+			}
+
+			var tags []string
+			if p.Department.Label != "" {
+				tags = append(tags, p.Department.Label)
+			}
+			if p.ExperienceLevel.Label != "" {
+				tags = append(tags, p.ExperienceLevel.Label)
+			}
+
+			date, _ := time.Parse(time.RFC3339, p.ReleasedDate)
+
+			link := fmt.Sprintf("https://jobs.smartrecruiters.com/%s/%s", company.Token, p.ID)
+			jobs = append(jobs, Job{
+				ID:             makeJobID(companySlug(company.Name), p.ID, link),
+				Title:          p.Name,
+				Link:           link,
+				Source:         company.Name,
+				Company:        company.Name,
+				Location:       location,
+				WorkMode:       workMode,
+				EmploymentType: p.TypeOfEmployment.Label,
+				Tags:           tags,
+				Date:           date,
+			})
+		}
+
+		return (page+1)*smartRecruitersPageSize < data.TotalFound, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("smartrecruiters %s: %w", company.Token, err)
+	}
+
+	return jobs, nil
+}
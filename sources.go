@@ -0,0 +1,127 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Source is a job board, aggregator or scraper that can be polled for jobs
+type Source interface {
+	Name() string      // Display name used in logs, e.g. "LinkedIn"
+	ConfigKey() string // Key under `sources:` in config.yaml, e.g. "linkedin"
+	Fetch(ctx context.Context) ([]Job, error)
+}
+
+// funcSource adapts a plain fetch function to the Source interface
+type funcSource struct {
+	name  string
+	key   string
+	fetch func(ctx context.Context) ([]Job, error)
+}
+
+func (s funcSource) Name() string      { return s.name }
+func (s funcSource) ConfigKey() string { return s.key }
+
+func (s funcSource) Fetch(ctx context.Context) ([]Job, error) {
+	return s.fetch(ctx)
+}
+
+// newSource wraps a fetch function. fetch should stop when ctx is done and
+// return the jobs it has so far.
+func newSource(name, key string, fetch func(ctx context.Context) ([]Job, error)) Source {
+	return funcSource{name: name, key: key, fetch: fetch}
+}
+
+var (
+	sourceRegistry []Source
+	registryKeys   = map[string]bool{}
+)
+
+// registerSource adds a source to the registry. Called from init() in each
+// source file, so adding a new source never requires touching main.go.
+func registerSource(s Source) {
+	if registryKeys[s.ConfigKey()] {
+		panic(fmt.Sprintf("source %q registered twice", s.ConfigKey()))
+	}
+	registryKeys[s.ConfigKey()] = true
+	sourceRegistry = append(sourceRegistry, s)
+}
+
+// registeredSources returns every known source in registration order
+func registeredSources() []Source {
+	return sourceRegistry
+}
+
+// enabledSources returns the sources switched on in config.yaml
+func enabledSources(c Config) []Source {
+	var enabled []Source
+	for _, s := range sourceRegistry {
+		if c.Sources[s.ConfigKey()] {
+			enabled = append(enabled, s)
+		}
+	}
+	return enabled
+}
+
+// unknownSourceKeys lists config keys that don't match any registered source,
+// which usually means a typo in config.yaml
+func unknownSourceKeys(c Config) []string {
+	var unknown []string
+	for key := range c.Sources {
+		if !registryKeys[key] {
+			unknown = append(unknown, key)
+		}
+	}
+	sort.Strings(unknown)
+	return unknown
+}
+
+// SourceResult is the outcome of polling a single source
+type SourceResult struct {
+	Source     Source
+	Jobs       []Job
+	Err        error
+	HTTPStatus int // Status of the source's last response, 0 if it got none
+}
+
+// sourceGracePeriod is how long fetchFromSources waits, once ctx is done, for
+// sources to return what they have before it stops waiting for them
+const sourceGracePeriod = 5 * time.Second
+
+// fetchFromSources polls the given sources in parallel and returns one result
+// per source, in the same order as the input. A source still running after
+// ctx's deadline and the grace period is reported as timed out.
+func fetchFromSources(ctx context.Context, sources []Source) []SourceResult {
+	type indexedResult struct {
+		idx    int
+		result SourceResult
+	}
+	done := make(chan indexedResult, len(sources)) // Buffered so late sources never block
+
+	results := make([]SourceResult, len(sources))
+	for i, s := range sources {
+		results[i] = SourceResult{Source: s, Err: fmt.Errorf("still running at the deadline")}
+		go func(idx int, src Source) {
+			traced, trace := withStatusTrace(ctx)
+			jobs, err := src.Fetch(traced)
+			done <- indexedResult{idx, SourceResult{Source: src, Jobs: jobs, Err: err, HTTPStatus: trace.last()}}
+		}(i, s)
+	}
+
+	expired := ctx.Done()
+	var grace <-chan time.Time
+	for received := 0; received < len(sources); {
+		select {
+		case r := <-done:
+			results[r.idx] = r.result
+			received++
+		case <-expired:
+			expired, grace = nil, time.After(sourceGracePeriod)
+		case <-grace:
+			return results
+		}
+	}
+	return results
+}
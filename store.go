@@ -0,0 +1,343 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// StorageConfig selects where job history is kept
+type StorageConfig struct {
+	Backend     string `yaml:"backend"`      // "json" (default) or "sqlite"
+	Path        string `yaml:"path"`         // jobs.json or jobs.db
+	MigrateFrom string `yaml:"migrate_from"` // JSON history imported once into a fresh SQLite store
+}
+
+// JobRecord stores a job with the timestamps of when we first and last saw it
+type JobRecord struct {
+	Job        Job    `json:"job"`
+	FirstSeen  int64  `json:"first_seen"`            // Unix timestamp
+	LastSeen   int64  `json:"last_seen,omitempty"`   // Unix timestamp of the latest run that returned it
+	NotifiedAt int64  `json:"notified_at,omitempty"` // Unix timestamp of the Telegram alert, 0 if never sent
+	AIScore    int    `json:"ai_score,omitempty"`
+	AIReason   string `json:"ai_reason,omitempty"`
+	ScoredAt   int64  `json:"scored_at,omitempty"` // Unix timestamp, 0 if never scored
+}
+
+// Store persists job history between runs
+type Store interface {
+	// Load returns every stored job record keyed by job ID
+	Load() (map[string]JobRecord, error)
+	// RecordRun stores the jobs returned by one run and updates their last-seen time
+	RecordRun(runAt time.Time, jobs []Job) error
+	// SaveScore stores the AI match result for a job
+	SaveScore(jobID string, score int, reason string, at time.Time) error
+	// MarkNotified records that an alert was sent for these jobs
+	MarkNotified(jobIDs []string, at time.Time) error
+	// Prune drops jobs that haven't been seen since cutoff
+	Prune(cutoff time.Time) error
+	// LoadHealth returns the health history of sources and company pages
+	LoadHealth() (map[string]HealthRecord, error)
+	// SaveHealth replaces the stored health history
+	SaveHealth(records map[string]HealthRecord) error
+	// Close flushes pending writes and releases the backend
+	Close() error
+}
+
+// openStore opens the backend selected in config.yaml
+func openStore(c StorageConfig) (Store, error) {
+	switch strings.ToLower(c.Backend) {
+	case "", "json":
+		return openJSONStore(c.path())
+	case "sqlite":
+		path := c.path()
+		migrateFrom := c.MigrateFrom
+		if migrateFrom == "" {
+			migrateFrom = "jobs.json"
+		}
+		return openSQLiteStore(path, migrateFrom)
+	default:
+		return nil, fmt.Errorf("unknown storage backend %q", c.Backend)
+	}
+}
+
+// path is the configured file, or the backend's default
+func (c StorageConfig) path() string {
+	switch {
+	case c.Path != "":
+		return c.Path
+	case strings.ToLower(c.Backend) == "sqlite":
+		return "jobs.db"
+	}
+	return "jobs.json"
+}
+
+// loadHealthHistory reads the stored health history without opening the
+// store: nothing is migrated, imported or upgraded, so it never writes
+func loadHealthHistory(c StorageConfig) (map[string]HealthRecord, error) {
+	switch strings.ToLower(c.Backend) {
+	case "", "json":
+		return readHealthFile(jsonHealthPath(c.path()))
+	case "sqlite":
+		return readSQLiteHealth(c.path())
+	default:
+		return nil, fmt.Errorf("unknown storage backend %q", c.Backend)
+	}
+}
+
+// mergeJob refreshes a stored job with newly fetched data, keeping stored
+// fields the new fetch didn't provide (e.g. records migrated from bare IDs)
+func mergeJob(stored, fresh Job) Job {
+	if fresh.Title != "" {
+		stored.Title = fresh.Title
+	}
+	if fresh.Link != "" {
+		stored.Link = fresh.Link
+	}
+	if fresh.Source != "" {
+		stored.Source = fresh.Source
+	}
+	if fresh.Company != "" {
+		stored.Company = fresh.Company
+	}
+	if fresh.Location != "" {
+		stored.Location = fresh.Location
+	}
+	if fresh.WorkMode != "" {
+		stored.WorkMode = fresh.WorkMode
+	}
+	if fresh.hasExperience() {
+		stored.ExperienceMin = fresh.ExperienceMin
+		stored.ExperienceMax = fresh.ExperienceMax
+	}
+	if fresh.EmploymentType != "" {
+		stored.EmploymentType = fresh.EmploymentType
+	}
+	if fresh.Description != "" {
+		stored.Description = fresh.Description
+	}
+	if fresh.Salary != "" {
+		stored.Salary = fresh.Salary
+	}
+	if len(fresh.Tags) > 0 {
+		stored.Tags = fresh.Tags
+	}
+	if !fresh.Date.IsZero() {
+		stored.Date = fresh.Date
+	}
+	return stored
+}
+
+// ================== JSON FILE STORE ==================
+// The original jobs.json format: one array, fully rewritten on Close. Health
+// history goes in health.json beside it, so jobs.json keeps its format.
+
+type jsonStore struct {
+	path    string
+	mu      sync.Mutex
+	records map[string]JobRecord
+
+	healthPath    string
+	health        map[string]HealthRecord
+	healthChanged bool
+}
+
+func openJSONStore(path string) (*jsonStore, error) {
+	records, err := readJobRecordsFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &jsonStore{
+		path:       path,
+		records:    records,
+		healthPath: jsonHealthPath(path),
+	}
+	if s.health, err = readHealthFile(s.healthPath); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func jsonHealthPath(jobsPath string) string {
+	return filepath.Join(filepath.Dir(jobsPath), "health.json")
+}
+
+// readHealthFile reads health.json; a missing file is an empty history
+func readHealthFile(path string) (map[string]HealthRecord, error) {
+	health := map[string]HealthRecord{}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return health, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &health); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return health, nil
+}
+
+// readJobRecordsFile reads a jobs.json file in any historical format, moving
+// old-style job IDs to makeJobID's. A missing file is an empty history.
+func readJobRecordsFile(path string) (map[string]JobRecord, error) {
+	file, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return map[string]JobRecord{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Try new format first (with timestamps)
+	var records []JobRecord
+	if err := json.Unmarshal(file, &records); err == nil && len(records) > 0 && records[0].FirstSeen != 0 {
+		seen := map[string]JobRecord{}
+		for _, r := range records {
+			// Records written before last_seen existed only know when they were first seen
+			if r.LastSeen == 0 {
+				r.LastSeen = r.FirstSeen
+			}
+			seen[r.Job.ID] = r
+		}
+		return migrateJobIDs(seen), nil
+	}
+
+	// Fallback: old format (just jobs without timestamps)
+	var jobs []Job
+	json.Unmarshal(file, &jobs)
+
+	seen := map[string]JobRecord{}
+	now := time.Now().Unix()
+	for _, j := range jobs {
+		seen[j.ID] = JobRecord{Job: j, FirstSeen: now, LastSeen: now} // Assume they were seen now
+	}
+	return migrateJobIDs(seen), nil
+}
+
+func (s *jsonStore) Load() (map[string]JobRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	out := make(map[string]JobRecord, len(s.records))
+	for id, r := range s.records {
+		out[id] = r
+	}
+	return out, nil
+}
+
+func (s *jsonStore) RecordRun(runAt time.Time, jobs []Job) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := runAt.Unix()
+	for _, j := range jobs {
+		r, exists := s.records[j.ID]
+		if exists {
+			r.Job = mergeJob(r.Job, j)
+		} else {
+			r = JobRecord{Job: j, FirstSeen: now}
+		}
+		r.LastSeen = now
+		s.records[j.ID] = r
+	}
+	return nil
+}
+
+func (s *jsonStore) SaveScore(jobID string, score int, reason string, at time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if r, ok := s.records[jobID]; ok {
+		r.AIScore = score
+		r.AIReason = reason
+		r.ScoredAt = at.Unix()
+		s.records[jobID] = r
+	}
+	return nil
+}
+
+func (s *jsonStore) MarkNotified(jobIDs []string, at time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, id := range jobIDs {
+		if r, ok := s.records[id]; ok {
+			r.NotifiedAt = at.Unix()
+			s.records[id] = r
+		}
+	}
+	return nil
+}
+
+func (s *jsonStore) Prune(cutoff time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for id, r := range s.records {
+		if r.LastSeen < cutoff.Unix() {
+			delete(s.records, id)
+		}
+	}
+	return nil
+}
+
+func (s *jsonStore) LoadHealth() (map[string]HealthRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	out := make(map[string]HealthRecord, len(s.health))
+	for key, r := range s.health {
+		out[key] = r
+	}
+	return out, nil
+}
+
+func (s *jsonStore) SaveHealth(records map[string]HealthRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.health = records
+	s.healthChanged = true
+	return nil
+}
+
+func (s *jsonStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.healthChanged {
+		data, err := json.MarshalIndent(s.health, "", "  ") // Keys come out sorted
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(s.healthPath, data, 0644); err != nil {
+			return err
+		}
+	}
+
+	records := make([]JobRecord, 0, len(s.records))
+	for _, r := range s.records {
+		records = append(records, r)
+	}
+
+	// Newest first, with a stable order so jobs.json diffs stay readable
+	sort.Slice(records, func(i, k int) bool {
+		if records[i].FirstSeen != records[k].FirstSeen {
+			return records[i].FirstSeen > records[k].FirstSeen
+		}
+		return records[i].Job.ID < records[k].Job.ID
+	})
+
+	data, err := json.MarshalIndent(records, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(s.path, data, 0644)
+}
@@ -0,0 +1,359 @@
+package main
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	_ "modernc.org/sqlite" // Pure-Go driver, no cgo needed in GitHub Actions
+)
+
+// ================== SQLITE STORE ==================
+// Jobs, per-run sightings, AI scores, notification status and source health
+// in one file
+
+const sqliteSchema = `
+CREATE TABLE IF NOT EXISTS jobs (
+	id          TEXT PRIMARY KEY,
+	title       TEXT NOT NULL DEFAULT '',
+	link        TEXT NOT NULL DEFAULT '',
+	source      TEXT NOT NULL DEFAULT '',
+	data        TEXT NOT NULL,               -- full Job as JSON
+	first_seen  INTEGER NOT NULL,
+	last_seen   INTEGER NOT NULL,
+	notified_at INTEGER NOT NULL DEFAULT 0
+);
+CREATE INDEX IF NOT EXISTS jobs_last_seen ON jobs(last_seen);
+
+CREATE TABLE IF NOT EXISTS runs (
+	id         INTEGER PRIMARY KEY AUTOINCREMENT,
+	started_at INTEGER NOT NULL,
+	job_count  INTEGER NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS sightings (
+	run_id INTEGER NOT NULL REFERENCES runs(id) ON DELETE CASCADE,
+	job_id TEXT NOT NULL,
+	PRIMARY KEY (run_id, job_id)
+);
+CREATE INDEX IF NOT EXISTS sightings_job ON sightings(job_id);
+
+CREATE TABLE IF NOT EXISTS ai_scores (
+	job_id    TEXT PRIMARY KEY,
+	score     INTEGER NOT NULL,
+	reason    TEXT NOT NULL DEFAULT '',
+	scored_at INTEGER NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS health (
+	key                  TEXT PRIMARY KEY,  -- "source:linkedin", "company:Stripe"
+	kind                 TEXT NOT NULL,
+	name                 TEXT NOT NULL,
+	consecutive_failures INTEGER NOT NULL DEFAULT 0,
+	zero_streak          INTEGER NOT NULL DEFAULT 0,
+	last_jobs            INTEGER NOT NULL DEFAULT 0,
+	last_status          INTEGER NOT NULL DEFAULT 0,
+	last_error           TEXT NOT NULL DEFAULT '',
+	last_success         INTEGER NOT NULL DEFAULT 0,
+	last_checked         INTEGER NOT NULL,
+	open_until           INTEGER NOT NULL DEFAULT 0,
+	trips                INTEGER NOT NULL DEFAULT 0,
+	recent_jobs          TEXT NOT NULL DEFAULT '[]', -- JSON array of recent normal job counts
+	last_good_run        INTEGER NOT NULL DEFAULT 0,
+	last_good_jobs       INTEGER NOT NULL DEFAULT 0,
+	anomaly              TEXT NOT NULL DEFAULT ''
+);
+
+CREATE TABLE IF NOT EXISTS meta (
+	key   TEXT PRIMARY KEY,
+	value TEXT NOT NULL
+);
+`
+
+type sqliteStore struct {
+	db *sql.DB
+}
+
+func openSQLiteStore(path, migrateFrom string) (*sqliteStore, error) {
+	db, err := sql.Open("sqlite", path+"?_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)&_pragma=foreign_keys(1)")
+	if err != nil {
+		return nil, err
+	}
+	// A single connection keeps writes serialised; SQLite allows only one writer anyway
+	db.SetMaxOpenConns(1)
+
+	if _, err := db.Exec(sqliteSchema); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("create schema: %w", err)
+	}
+
+	s := &sqliteStore{db: db}
+	if err := s.migrateJSON(migrateFrom); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("migrate %s: %w", migrateFrom, err)
+	}
+	return s, nil
+}
+
+// migrateJSON imports a jobs.json history the first time the database is
+// opened. The import is recorded in meta so it never runs twice.
+func (s *sqliteStore) migrateJSON(path string) error {
+	var done string
+	err := s.db.QueryRow(`SELECT value FROM meta WHERE key = 'migrated_from'`).Scan(&done)
+	if err == nil {
+		return nil
+	}
+	if err != sql.ErrNoRows {
+		return err
+	}
+
+	records, err := readJobRecordsFile(path)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, r := range records {
+		if err := upsertJobRecord(tx, r); err != nil {
+			return err
+		}
+		if r.ScoredAt != 0 {
+			if _, err := tx.Exec(`INSERT OR REPLACE INTO ai_scores (job_id, score, reason, scored_at) VALUES (?, ?, ?, ?)`,
+				r.Job.ID, r.AIScore, r.AIReason, r.ScoredAt); err != nil {
+				return err
+			}
+		}
+	}
+
+	if _, err := tx.Exec(`INSERT INTO meta (key, value) VALUES ('migrated_from', ?)`, path); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	if len(records) > 0 {
+		fmt.Printf("Migrated %d job records from %s into SQLite\n", len(records), path)
+	}
+	return nil
+}
+
+func upsertJobRecord(tx *sql.Tx, r JobRecord) error {
+	data, err := json.Marshal(r.Job)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(`
+		INSERT INTO jobs (id, title, link, source, data, first_seen, last_seen, notified_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT(id) DO UPDATE SET
+			title = excluded.title, link = excluded.link, source = excluded.source, data = excluded.data,
+			first_seen = MIN(first_seen, excluded.first_seen),
+			last_seen = MAX(last_seen, excluded.last_seen),
+			notified_at = MAX(notified_at, excluded.notified_at)`,
+		r.Job.ID, r.Job.Title, r.Job.Link, r.Job.Source, string(data), r.FirstSeen, r.LastSeen, r.NotifiedAt)
+	return err
+}
+
+func (s *sqliteStore) Load() (map[string]JobRecord, error) {
+	rows, err := s.db.Query(`
+		SELECT j.data, j.first_seen, j.last_seen, j.notified_at,
+		       COALESCE(a.score, 0), COALESCE(a.reason, ''), COALESCE(a.scored_at, 0)
+		FROM jobs j LEFT JOIN ai_scores a ON a.job_id = j.id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	records := map[string]JobRecord{}
+	for rows.Next() {
+		var data string
+		var r JobRecord
+		if err := rows.Scan(&data, &r.FirstSeen, &r.LastSeen, &r.NotifiedAt, &r.AIScore, &r.AIReason, &r.ScoredAt); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal([]byte(data), &r.Job); err != nil {
+			return nil, err
+		}
+		records[r.Job.ID] = r
+	}
+	return records, rows.Err()
+}
+
+func (s *sqliteStore) RecordRun(runAt time.Time, jobs []Job) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.Exec(`INSERT INTO runs (started_at, job_count) VALUES (?, ?)`, runAt.Unix(), len(jobs))
+	if err != nil {
+		return err
+	}
+	runID, err := res.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	now := runAt.Unix()
+	for _, j := range jobs {
+		// Keep fields the stored copy has but this fetch didn't return
+		stored := Job{ID: j.ID}
+		var data string
+		err := tx.QueryRow(`SELECT data FROM jobs WHERE id = ?`, j.ID).Scan(&data)
+		if err == nil {
+			json.Unmarshal([]byte(data), &stored)
+		} else if err != sql.ErrNoRows {
+			return err
+		}
+
+		r := JobRecord{Job: mergeJob(stored, j), FirstSeen: now, LastSeen: now}
+		if err := upsertJobRecord(tx, r); err != nil {
+			return err
+		}
+		if _, err := tx.Exec(`INSERT OR IGNORE INTO sightings (run_id, job_id) VALUES (?, ?)`, runID, j.ID); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (s *sqliteStore) SaveScore(jobID string, score int, reason string, at time.Time) error {
+	_, err := s.db.Exec(`INSERT OR REPLACE INTO ai_scores (job_id, score, reason, scored_at) VALUES (?, ?, ?, ?)`,
+		jobID, score, reason, at.Unix())
+	return err
+}
+
+func (s *sqliteStore) MarkNotified(jobIDs []string, at time.Time) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, id := range jobIDs {
+		if _, err := tx.Exec(`UPDATE jobs SET notified_at = ? WHERE id = ?`, at.Unix(), id); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (s *sqliteStore) Prune(cutoff time.Time) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	c := cutoff.Unix()
+	stmts := []string{
+		`DELETE FROM ai_scores WHERE job_id IN (SELECT id FROM jobs WHERE last_seen < ?)`,
+		`DELETE FROM jobs WHERE last_seen < ?`,
+		`DELETE FROM runs WHERE started_at < ?`, // sightings cascade
+	}
+	for _, stmt := range stmts {
+		if _, err := tx.Exec(stmt, c); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (s *sqliteStore) LoadHealth() (map[string]HealthRecord, error) {
+	return queryHealth(s.db)
+}
+
+// readSQLiteHealth reads the health table of a database opened read-only
+func readSQLiteHealth(path string) (map[string]HealthRecord, error) {
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return map[string]HealthRecord{}, nil
+	}
+	db, err := sql.Open("sqlite", "file:"+path+"?mode=ro&_pragma=busy_timeout(5000)")
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	var tables int
+	if err := db.QueryRow(`SELECT count(*) FROM sqlite_master WHERE type = 'table' AND name = 'health'`).Scan(&tables); err != nil {
+		return nil, err
+	}
+	if tables == 0 {
+		return map[string]HealthRecord{}, nil // Written before health tracking
+	}
+	return queryHealth(db)
+}
+
+func queryHealth(db *sql.DB) (map[string]HealthRecord, error) {
+	rows, err := db.Query(`
+		SELECT kind, name, consecutive_failures, zero_streak, last_jobs, last_status,
+		       last_error, last_success, last_checked, open_until, trips,
+		       recent_jobs, last_good_run, last_good_jobs, anomaly
+		FROM health`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	records := map[string]HealthRecord{}
+	for rows.Next() {
+		var r HealthRecord
+		var recent string
+		if err := rows.Scan(&r.Kind, &r.Name, &r.ConsecutiveFailures, &r.ZeroStreak, &r.LastJobs, &r.LastStatus,
+			&r.LastError, &r.LastSuccess, &r.LastChecked, &r.OpenUntil, &r.Trips,
+			&recent, &r.LastGoodRun, &r.LastGoodJobs, &r.Anomaly); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal([]byte(recent), &r.RecentJobs); err != nil {
+			return nil, err
+		}
+		records[healthKey(r.Kind, r.Name)] = r
+	}
+	return records, rows.Err()
+}
+
+func (s *sqliteStore) SaveHealth(records map[string]HealthRecord) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(`DELETE FROM health`); err != nil {
+		return err
+	}
+	for key, r := range records {
+		recent, err := json.Marshal(r.RecentJobs)
+		if err != nil {
+			return err
+		}
+		if r.RecentJobs == nil {
+			recent = []byte("[]")
+		}
+		if _, err := tx.Exec(`
+			INSERT INTO health (key, kind, name, consecutive_failures, zero_streak, last_jobs, last_status,
+			                    last_error, last_success, last_checked, open_until, trips,
+			                    recent_jobs, last_good_run, last_good_jobs, anomaly)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			key, r.Kind, r.Name, r.ConsecutiveFailures, r.ZeroStreak, r.LastJobs, r.LastStatus,
+			r.LastError, r.LastSuccess, r.LastChecked, r.OpenUntil, r.Trips,
+			string(recent), r.LastGoodRun, r.LastGoodJobs, r.Anomaly); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (s *sqliteStore) Close() error {
+	return s.db.Close()
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"bytes"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestLoadHealthHistoryJSON(t *testing.T) {
+	dir := t.TempDir()
+	jobsPath := filepath.Join(dir, "jobs.json")
+
+	// No files yet: an empty history, and nothing created
+	records, err := loadHealthHistory(StorageConfig{Path: jobsPath})
+	if err != nil || len(records) != 0 {
+		t.Fatalf("empty history = %v, %v", records, err)
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+		t.Errorf("reading health created %d files", len(entries))
+	}
+
+	store, err := openJSONStore(jobsPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]HealthRecord{
+		healthKey("source", "linkedin"): {Kind: "source", Name: "linkedin", LastJobs: 12, RecentJobs: []int{10, 12}},
+	}
+	store.SaveHealth(want)
+	if err := store.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := loadHealthHistory(StorageConfig{Path: jobsPath})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("health = %+v, want %+v", got, want)
+	}
+}
+
+func TestLoadHealthHistorySQLiteReadOnly(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "jobs.db")
+
+	// Written without WAL, so a stray write would show up in the file itself
+	db, err := sql.Open("sqlite", dbPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = db.Exec(sqliteSchema); err == nil {
+		_, err = db.Exec(`
+			INSERT INTO health (key, kind, name, consecutive_failures, last_status, last_error, last_checked)
+			VALUES ('company:Acme', 'company', 'Acme', 5, 403, 'status 403', 1);`)
+	}
+	db.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	before, _ := os.ReadFile(dbPath)
+
+	records, err := loadHealthHistory(StorageConfig{Backend: "sqlite", Path: dbPath})
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := records[healthKey("company", "Acme")]
+	if len(records) != 1 || r.ConsecutiveFailures != 5 || r.LastStatus != 403 || r.LastError != "status 403" || r.Anomaly != "" {
+		t.Errorf("records = %+v", records)
+	}
+
+	after, _ := os.ReadFile(dbPath)
+	if !bytes.Equal(before, after) {
+		t.Errorf("reading health changed the database")
+	}
+	if _, err := os.Stat(dbPath + "-wal"); err == nil {
+		t.Errorf("reading health left a WAL file")
+	}
+}
+
+func TestLoadHealthHistorySQLite(t *testing.T) {
+	dir := t.TempDir()
+	dbPath := filepath.Join(dir, "jobs.db")
+	store, err := openSQLiteStore(dbPath, filepath.Join(dir, "none.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]HealthRecord{
+		healthKey("company", "Acme"): {
+			Kind: "company", Name: "Acme", LastJobs: 3, LastChecked: time.Now().Unix(),
+			RecentJobs: []int{20, 21}, LastGoodRun: 1, LastGoodJobs: 21, Anomaly: "3 jobs, usually about 21",
+		},
+	}
+	if err := store.SaveHealth(want); err != nil {
+		t.Fatal(err)
+	}
+	store.Close()
+
+	got, err := loadHealthHistory(StorageConfig{Backend: "sqlite", Path: dbPath})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("health = %+v, want %+v", got, want)
+	}
+}
@@ -1,30 +1,28 @@
 package main
 
 import (
+	"bytes"
+	"context"
 	"fmt"
-	"io"
-	"net/http"
 	"strings"
 
 	"github.com/PuerkitoBio/goquery"
 )
 
+func init() {
+	registerSource(newSource("Wellfound", "wellfound", fetchWellfoundJobs))
+}
+
 // fetchWellfoundJobs - HTTP only scraping
-func fetchWellfoundJobs() ([]Job, error) {
+func fetchWellfoundJobs(ctx context.Context) ([]Job, error) {
 	url := "https://wellfound.com/role/r/software-engineer"
 
-	req, _ := http.NewRequest("GET", url, nil)
-	req.Header.Set("User-Agent", "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36")
-	req.Header.Set("Accept", "text/html,application/xhtml+xml")
-
-	resp, err := http.DefaultClient.Do(req)
+	body, err := httpGet(ctx, url, profileBrowser)
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
 
-	body, _ := io.ReadAll(resp.Body)
-	doc, err := goquery.NewDocumentFromReader(strings.NewReader(string(body)))
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
 	if err != nil {
 		return nil, err
 	}
@@ -52,7 +50,7 @@ func fetchWellfoundJobs() ([]Job, error) {
 		}
 
 		jobs = append(jobs, Job{
-			ID:     "wellfound-" + slug,
+			ID:     makeJobID("wellfound", slug, link),
 			Title:  title,
 			Link:   link,
 			Source: "Wellfound",
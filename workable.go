@@ -0,0 +1,126 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ================== WORKABLE ==================
+// Public widget API: apply.workable.com/api/v1/widget/accounts/{subdomain}
+
+func init() {
+	registerATS("workable", fetchWorkableJobs)
+	registerATSDetail("workable", fetchWorkableDetail)
+}
+
+type workableResponse struct {
+	Jobs []struct {
+		Title          string `json:"title"`
+		Shortcode      string `json:"shortcode"`
+		EmploymentType string `json:"employment_type"`
+		Telecommuting  bool   `json:"telecommuting"`
+		Department     string `json:"department"`
+		URL            string `json:"url"`
+		PublishedOn    string `json:"published_on"` // YYYY-MM-DD
+		CreatedAt      string `json:"created_at"`   // YYYY-MM-DD
+		Country        string `json:"country"`
+		City           string `json:"city"`
+		State          string `json:"state"`
+		Locations      []struct {
+			Country string `json:"country"`
+			City    string `json:"city"`
+			Region  string `json:"region"`
+		} `json:"locations"`
+	} `json:"jobs"`
+}
+
+type workableJobDetail struct {
+	Description  string `json:"description"`  // HTML
+	Requirements string `json:"requirements"` // HTML
+	Benefits     string `json:"benefits"`
+	Workplace    string `json:"workplace"` // on_site, hybrid, remote
+}
+
+// fetchWorkableDetail reads one job from the v2 account API
+func fetchWorkableDetail(ctx context.Context, company CompanyCareer, job Job) (Job, error) {
+	apiURL := fmt.Sprintf("https://apply.workable.com/api/v2/accounts/%s/jobs/%s", company.Token, atsJobID(company, job))
+
+	var data workableJobDetail
+	if err := getJSON(ctx, apiURL, &data); err != nil {
+		return Job{}, fmt.Errorf("workable %s: %w", company.Token, err)
+	}
+
+	return Job{
+		WorkMode:    detectWorkMode(strings.ReplaceAll(data.Workplace, "_", "-")),
+		Description: joinNonEmpty("\n\n", htmlToText(data.Description), htmlToText(data.Requirements)),
+	}, nil
+}
+
+// fetchWorkableJobs reads a company's Workable job widget.
+// company.Token is the account subdomain, e.g. "huggingface" for apply.workable.com/huggingface.
+func fetchWorkableJobs(ctx context.Context, company CompanyCareer) ([]Job, error) {
+	if company.Token == "" {
+		return nil, fmt.Errorf("workable: no account subdomain for %s", company.Name)
+	}
+
+	url := fmt.Sprintf("https://apply.workable.com/api/v1/widget/accounts/%s?details=false", company.Token)
+
+	var data workableResponse
+	if err := getJSON(ctx, url, &data); err != nil {
+		return nil, fmt.Errorf("workable %s: %w", company.Token, err)
+	}
+
+	var jobs []Job
+	for _, j := range data.Jobs {
+		if !isEntryLevelJob(j.Title) {
+			continue
+		}
+
+		var locations []string
+		for _, l := range j.Locations {
+			locations = append(locations, joinNonEmpty(", ", l.City, l.Region, l.Country))
+		}
+		location := strings.Join(locations, "; ")
+		if location == "" {
+			location = joinNonEmpty(", ", j.City, j.State, j.Country)
+		}
+
+		workMode := detectWorkMode(location)
+		if j.Telecommuting {
+			workMode = WorkModeRemote
+		}
+
+		link := j.URL
+		if link == "" {
+			link = fmt.Sprintf("https://apply.workable.com/%s/j/%s/", company.Token, j.Shortcode)
+		}
+
+		published := j.PublishedOn
+		if published == "" {
+			published = j.CreatedAt
+		}
+		date, _ := time.Parse("2006-01-02", published)
+
+		var tags []string
+		if j.Department != "" {
+			tags = append(tags, j.Department)
+		}
+
+		jobs = append(jobs, Job{
+			ID:             makeJobID(companySlug(company.Name), j.Shortcode, link),
+			Title:          j.Title,
+			Link:           link,
+			Source:         company.Name,
+			Company:        company.Name,
+			Location:       location,
+			WorkMode:       workMode,
+			EmploymentType: j.EmploymentType,
+			Tags:           tags,
+			Date:           date,
+		})
+	}
+
+	return jobs, nil
+}
@@ -0,0 +1,246 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// ================== WORKDAY ==================
+// Career sites on {tenant}.wdN.myworkdayjobs.com are JavaScript apps backed by
+// the CXS search API: POST /wday/cxs/{tenant}/{site}/jobs
+
+func init() {
+	registerATS("workday", fetchWorkdayJobs)
+	registerATSDetail("workday", fetchWorkdayDetail)
+}
+
+const (
+	workdayPageSize = 20 // The API rejects larger pages
+	workdayMaxPages = 10
+)
+
+type workdaySearchRequest struct {
+	AppliedFacets map[string][]string `json:"appliedFacets"`
+	Limit         int                 `json:"limit"`
+	Offset        int                 `json:"offset"`
+	SearchText    string              `json:"searchText"`
+}
+
+type workdaySearchResponse struct {
+	Total       int `json:"total"`
+	JobPostings []struct {
+		Title         string   `json:"title"`
+		ExternalPath  string   `json:"externalPath"` // e.g. /job/Bangalore/Software-Engineer_JR1234
+		LocationsText string   `json:"locationsText"`
+		PostedOn      string   `json:"postedOn"` // e.g. "Posted 3 Days Ago"
+		BulletFields  []string `json:"bulletFields"`
+	} `json:"jobPostings"`
+}
+
+type workdayDetailResponse struct {
+	JobPostingInfo struct {
+		JobDescription string `json:"jobDescription"` // HTML
+		Location       string `json:"location"`
+		TimeType       string `json:"timeType"`   // Full time, Part time
+		RemoteType     string `json:"remoteType"` // Remote, Hybrid, Flexible
+		StartDate      string `json:"startDate"`  // YYYY-MM-DD, when it was posted
+	} `json:"jobPostingInfo"`
+}
+
+// workdaySite is the tenant and site parsed from a myworkdayjobs.com URL
+type workdaySite struct {
+	Host   string              // nvidia.wd5.myworkdayjobs.com
+	Tenant string              // nvidia
+	Site   string              // NVIDIAExternalCareerSite
+	Facets map[string][]string // from the URL query, e.g. locationCountry
+	Search string              // from ?q= if present
+}
+
+var workdayLocaleRegex = regexp.MustCompile(`^[a-z]{2}-[A-Z]{2}$`)
+
+// workdayFacets are the query parameters Workday career sites use for search
+// filters. Anything else on the URL (tracking, source=, redirect state) would
+// be rejected by the API as an unknown facet.
+var workdayFacets = map[string]bool{
+	"locationCountry":             true,
+	"locationRegionStateProvince": true,
+	"locations":                   true,
+	"locationHierarchy1":          true,
+	"locationHierarchy2":          true,
+	"jobFamilyGroup":              true,
+	"jobFamily":                   true,
+	"timeType":                    true,
+	"workerSubType":               true,
+	"remoteType":                  true,
+}
+
+// parseWorkdayURL reads the tenant, site and filters from a career site URL.
+// company.Token may override them as "tenant/site".
+func parseWorkdayURL(company CompanyCareer) (workdaySite, error) {
+	u, err := url.Parse(company.URL)
+	if err != nil {
+		return workdaySite{}, err
+	}
+
+	site := workdaySite{
+		Host:   u.Host,
+		Tenant: strings.Split(u.Host, ".")[0],
+		Facets: map[string][]string{},
+	}
+
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(segments) > 0 && workdayLocaleRegex.MatchString(segments[0]) {
+		segments = segments[1:]
+	}
+	if len(segments) > 0 {
+		site.Site = segments[0]
+	}
+
+	if company.Token != "" {
+		parts := strings.SplitN(company.Token, "/", 2)
+		site.Tenant = parts[0]
+		if len(parts) == 2 {
+			site.Site = parts[1]
+		}
+	}
+
+	if site.Site == "" {
+		return workdaySite{}, fmt.Errorf("workday: no site name in %s", company.URL)
+	}
+
+	for key, values := range u.Query() {
+		switch {
+		case key == "q":
+			site.Search = values[0]
+		case workdayFacets[key]:
+			site.Facets[key] = values
+		}
+	}
+
+	return site, nil
+}
+
+// fetchWorkdayJobs pages through a Workday tenant's job search
+func fetchWorkdayJobs(ctx context.Context, company CompanyCareer) ([]Job, error) {
+	site, err := parseWorkdayURL(company)
+	if err != nil {
+		return nil, err
+	}
+
+	search := site.Search
+	if search == "" {
+		search = atsSearch(company)
+	}
+
+	apiURL := fmt.Sprintf("https://%s/wday/cxs/%s/%s/jobs", site.Host, site.Tenant, site.Site)
+
+	var jobs []Job
+	now := time.Now()
+
+	err = fetchPages(ctx, workdayMaxPages, func(page int) (bool, error) {
+		req := workdaySearchRequest{
+			AppliedFacets: site.Facets,
+			Limit:         workdayPageSize,
+			Offset:        page * workdayPageSize,
+			SearchText:    search,
+		}
+
+		var data workdaySearchResponse
+		if err := postJSON(ctx, apiURL, req, &data); err != nil {
+			return false, err
+		}
+
+		for _, p := range data.JobPostings {
+			if !isEntryLevelJob(p.Title) {
+				continue
+			}
+
+			link := fmt.Sprintf("https://%s/%s%s", site.Host, site.Site, p.ExternalPath)
+			jobs = append(jobs, Job{
+				ID:       makeJobID(companySlug(company.Name), workdayJobID(p.ExternalPath, p.BulletFields), link),
+				Title:    p.Title,
+				Link:     link,
+				Source:   company.Name,
+				Company:  company.Name,
+				Location: p.LocationsText,
+				WorkMode: detectWorkMode(p.LocationsText),
+				Date:     parseWorkdayPostedOn(p.PostedOn, now),
+			})
+		}
+
+		return len(data.JobPostings) == workdayPageSize && (page+1)*workdayPageSize < data.Total, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("workday %s: %w", site.Tenant, err)
+	}
+
+	return jobs, nil
+}
+
+// fetchWorkdayDetail reads one posting from the CXS API. The external path is
+// recovered from the link: https://{host}/{site}{externalPath}
+func fetchWorkdayDetail(ctx context.Context, company CompanyCareer, job Job) (Job, error) {
+	site, err := parseWorkdayURL(company)
+	if err != nil {
+		return Job{}, err
+	}
+
+	u, err := url.Parse(job.Link)
+	if err != nil {
+		return Job{}, err
+	}
+	externalPath := strings.TrimPrefix(u.Path, "/"+site.Site)
+
+	var data workdayDetailResponse
+	apiURL := fmt.Sprintf("https://%s/wday/cxs/%s/%s%s", site.Host, site.Tenant, site.Site, externalPath)
+	if err := getJSON(ctx, apiURL, &data); err != nil {
+		return Job{}, fmt.Errorf("workday %s: %w", site.Tenant, err)
+	}
+
+	info := data.JobPostingInfo
+	date, _ := time.Parse("2006-01-02", info.StartDate)
+	return Job{
+		Location:       info.Location,
+		WorkMode:       detectWorkMode(info.RemoteType),
+		EmploymentType: info.TimeType,
+		Description:    htmlToText(info.JobDescription),
+		Date:           date,
+	}, nil
+}
+
+// workdayJobID prefers the requisition ID (first bullet field), falling back to
+// the suffix of the external path: /job/Pune/Engineer_JR1234 -> JR1234
+func workdayJobID(externalPath string, bullets []string) string {
+	if len(bullets) > 0 && bullets[0] != "" && !strings.Contains(bullets[0], " ") {
+		return bullets[0]
+	}
+	if idx := strings.LastIndex(externalPath, "_"); idx >= 0 && idx < len(externalPath)-1 {
+		return externalPath[idx+1:]
+	}
+	return generateStableHash(externalPath)
+}
+
+var workdayDaysAgoRegex = regexp.MustCompile(`(\d+)\+?\s*days?\s*ago`)
+
+// parseWorkdayPostedOn turns "Posted Today", "Posted Yesterday" and
+// "Posted 3 Days Ago" into a date relative to now. "30+ Days Ago" becomes 30.
+func parseWorkdayPostedOn(postedOn string, now time.Time) time.Time {
+	lower := strings.ToLower(postedOn)
+	switch {
+	case strings.Contains(lower, "today"):
+		return now
+	case strings.Contains(lower, "yesterday"):
+		return now.AddDate(0, 0, -1)
+	}
+
+	if m := workdayDaysAgoRegex.FindStringSubmatch(lower); m != nil {
+		var days int
+		fmt.Sscanf(m[1], "%d", &days)
+		return now.AddDate(0, 0, -days)
+	}
+	return time.Time{}
+}
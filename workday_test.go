@@ -0,0 +1,107 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseWorkdayURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		company CompanyCareer
+		want    workdaySite
+		wantErr bool
+	}{
+		{
+			name:    "facet from query",
+			company: CompanyCareer{URL: "https://nvidia.wd5.myworkdayjobs.com/NVIDIAExternalCareerSite?locationCountry=c4f78be1a8f14da0ab49ce1162348a5e"},
+			want: workdaySite{
+				Host:   "nvidia.wd5.myworkdayjobs.com",
+				Tenant: "nvidia",
+				Site:   "NVIDIAExternalCareerSite",
+				Facets: map[string][]string{"locationCountry": {"c4f78be1a8f14da0ab49ce1162348a5e"}},
+			},
+		},
+		{
+			name:    "locale prefix, search text and tracking params",
+			company: CompanyCareer{URL: "https://autodesk.wd1.myworkdayjobs.com/en-US/Ext?q=engineer&source=linkedin&utm_campaign=x&timeType=full"},
+			want: workdaySite{
+				Host:   "autodesk.wd1.myworkdayjobs.com",
+				Tenant: "autodesk",
+				Site:   "Ext",
+				Facets: map[string][]string{"timeType": {"full"}},
+				Search: "engineer",
+			},
+		},
+		{
+			name:    "token overrides tenant and site",
+			company: CompanyCareer{URL: "https://careers.example.com/jobs", Token: "example/External"},
+			want: workdaySite{
+				Host:   "careers.example.com",
+				Tenant: "example",
+				Site:   "External",
+				Facets: map[string][]string{},
+			},
+		},
+		{
+			name:    "no site",
+			company: CompanyCareer{URL: "https://workday.wd5.myworkdayjobs.com/"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseWorkdayURL(tt.company)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseWorkdayURL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseWorkdayURL() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseWorkdayPostedOn(t *testing.T) {
+	now := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		postedOn string
+		want     time.Time
+	}{
+		{"Posted Today", now},
+		{"Posted Yesterday", now.AddDate(0, 0, -1)},
+		{"Posted 3 Days Ago", now.AddDate(0, 0, -3)},
+		{"Posted 1 Day Ago", now.AddDate(0, 0, -1)},
+		{"Posted 30+ Days Ago", now.AddDate(0, 0, -30)},
+		{"", time.Time{}},
+		{"Posted recently", time.Time{}},
+	}
+
+	for _, tt := range tests {
+		if got := parseWorkdayPostedOn(tt.postedOn, now); !got.Equal(tt.want) {
+			t.Errorf("parseWorkdayPostedOn(%q) = %v, want %v", tt.postedOn, got, tt.want)
+		}
+	}
+}
+
+func TestWorkdayJobID(t *testing.T) {
+	tests := []struct {
+		path    string
+		bullets []string
+		want    string
+	}{
+		{"/job/Pune/Engineer_JR1234", []string{"JR9999"}, "JR9999"},
+		{"/job/Pune/Engineer_JR1234", nil, "JR1234"},
+		{"/job/Pune/Engineer_JR1234", []string{"Posted 3 Days Ago"}, "JR1234"},
+		{"/job/Pune/Engineer", nil, generateStableHash("/job/Pune/Engineer")},
+	}
+
+	for _, tt := range tests {
+		if got := workdayJobID(tt.path, tt.bullets); got != tt.want {
+			t.Errorf("workdayJobID(%q, %q) = %q, want %q", tt.path, tt.bullets, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// ================== ZOHO RECRUIT ==================
+// Career sites on {portal}.zohorecruit.in (or .com/.eu) list openings from
+// GET /recruit/v2/public/Job_Openings?pagename=Careers&source=CareerSite
+
+func init() {
+	registerATS("zohorecruit", fetchZohoRecruitJobs)
+}
+
+type zohoRecruitResponse struct {
+	Data []struct {
+		ID             string `json:"id"`
+		PostingTitle   string `json:"Posting_Title"`
+		JobOpeningName string `json:"Job_Opening_Name"`
+		City           string `json:"City"`
+		State          string `json:"State"`
+		Country        string `json:"Country"`
+		RemoteJob      bool   `json:"Remote_Job"`
+		WorkExperience string `json:"Work_Experience"` // e.g. "0-1 year", "Fresher"
+		JobType        string `json:"Job_Type"`
+		Industry       string `json:"Industry"`
+		DateOpened     string `json:"Date_Opened"` // YYYY-MM-DD
+		JobDescription string `json:"Job_Description"`
+		Salary         string `json:"Salary"`
+	} `json:"data"`
+}
+
+var zohoSlugRegex = regexp.MustCompile(`[^a-z0-9]+`)
+
+// fetchZohoRecruitJobs reads a Zoho Recruit career site. The host comes from
+// company.URL; company.Token may name a career page other than "Careers".
+func fetchZohoRecruitJobs(ctx context.Context, company CompanyCareer) ([]Job, error) {
+	u, err := url.Parse(company.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	pageName := company.Token
+	if pageName == "" {
+		pageName = "Careers"
+	}
+
+	apiURL := fmt.Sprintf("https://%s/recruit/v2/public/Job_Openings?pagename=%s&source=CareerSite", u.Host, url.QueryEscape(pageName))
+
+	var data zohoRecruitResponse
+	if err := getJSON(ctx, apiURL, &data); err != nil {
+		return nil, fmt.Errorf("zohorecruit %s: %w", u.Host, err)
+	}
+
+	var jobs []Job
+	for _, j := range data.Data {
+		title := j.PostingTitle
+		if title == "" {
+			title = j.JobOpeningName
+		}
+
+		if !isEntryLevelJob(title + " " + j.WorkExperience) {
+			continue
+		}
+
+		location := joinNonEmpty(", ", j.City, j.State, j.Country)
+
+		workMode := detectWorkMode(location)
+		if j.RemoteJob {
+			workMode = WorkModeRemote
+		}
+
+		expMin, expMax := parseExperienceRange(j.WorkExperience)
+
+		var tags []string
+		if j.Industry != "" {
+			tags = append(tags, j.Industry)
+		}
+
+		date, _ := time.Parse("2006-01-02", j.DateOpened)
+		slug := strings.Trim(zohoSlugRegex.ReplaceAllString(strings.ToLower(title), "-"), "-")
+
+		link := fmt.Sprintf("https://%s/jobs/%s/%s/%s", u.Host, pageName, j.ID, slug)
+		jobs = append(jobs, Job{
+			ID:             makeJobID(companySlug(company.Name), j.ID, link),
+			Title:          title,
+			Link:           link,
+			Source:         company.Name,
+			Company:        company.Name,
+			Location:       location,
+			WorkMode:       workMode,
+			ExperienceMin:  expMin,
+			ExperienceMax:  expMax,
+			EmploymentType: j.JobType,
+			Description:    htmlToText(j.JobDescription),
+			Salary:         j.Salary,
+			Tags:           tags,
+			Date:           date,
+		})
+	}
+
+	return jobs, nil
+}